@@ -61,19 +61,33 @@ func newAddress(addressType byte) (address Address, err error) {
 }
 
 func bech32String(hrp NetworkPrefix, addr Address) string {
-	bytes, _ := addr.Serialize(DeSeriModeNoValidation)
-	s, err := bech32.Encode(string(hrp), bytes)
+	s, err := EncodeBech32(hrp, addr, bech32.Bech32)
 	if err != nil {
 		panic(err)
 	}
 	return s
 }
 
+// EncodeBech32 encodes the given address as a bech32 string using the given checksum variant.
+func EncodeBech32(hrp NetworkPrefix, addr Address, variant bech32.ChecksumVariant) (string, error) {
+	bytes, err := addr.Serialize(DeSeriModeNoValidation)
+	if err != nil {
+		return "", err
+	}
+	return bech32.EncodeVariant(string(hrp), bytes, variant)
+}
+
 // ParseBech32 decodes a bech32 encoded string.
+// Strings using the bech32m checksum variant are rejected, use ParseBech32Variant to decode those.
 func ParseBech32(s string) (NetworkPrefix, Address, error) {
-	hrp, addrData, err := bech32.Decode(s)
+	return ParseBech32Variant(s, bech32.Bech32)
+}
+
+// ParseBech32Variant decodes a string encoded using the given bech32 checksum variant.
+func ParseBech32Variant(s string, variant bech32.ChecksumVariant) (NetworkPrefix, Address, error) {
+	hrp, addrData, err := bech32.DecodeVariant(s, variant)
 	if err != nil {
-		return "", nil, fmt.Errorf("invalid bech32 encoding: %w", err)
+		return "", nil, fmt.Errorf("invalid %s encoding: %w", variant, err)
 	}
 
 	if len(addrData) == 0 {
@@ -130,6 +144,13 @@ func (edAddr *Ed25519Address) Bech32(hrp NetworkPrefix) string {
 	return bech32String(hrp, edAddr)
 }
 
+// VerifiedBy checks whether the given signature over the given message was produced by the holder of the address.
+// It returns ErrEd25519PubKeyAndAddrMismatch if the signature's public key does not correspond to the address
+// and ErrEd25519SignatureInvalid if the signature is not valid for the message.
+func (edAddr *Ed25519Address) VerifiedBy(sig *Ed25519Signature, message []byte) error {
+	return sig.Valid(message, edAddr)
+}
+
 func (edAddr *Ed25519Address) String() string {
 	return hex.EncodeToString(edAddr[:])
 }
@@ -161,6 +182,14 @@ func (edAddr *Ed25519Address) MarshalJSON() ([]byte, error) {
 	return json.Marshal(jEd25519Address)
 }
 
+// MarshalJSONBech32 is like MarshalJSON but encodes the address in its bech32 form using the given human readable part.
+func (edAddr *Ed25519Address) MarshalJSONBech32(hrp NetworkPrefix) ([]byte, error) {
+	jEd25519Address := &jsonEd25519Address{}
+	jEd25519Address.Address = edAddr.Bech32(hrp)
+	jEd25519Address.Type = int(AddressEd25519)
+	return json.Marshal(jEd25519Address)
+}
+
 func (edAddr *Ed25519Address) UnmarshalJSON(bytes []byte) error {
 	jEd25519Address := &jsonEd25519Address{}
 	if err := json.Unmarshal(bytes, jEd25519Address); err != nil {
@@ -200,7 +229,16 @@ type jsonEd25519Address struct {
 func (j *jsonEd25519Address) ToSerializable() (Serializable, error) {
 	addrBytes, err := hex.DecodeString(j.Address)
 	if err != nil {
-		return nil, fmt.Errorf("unable to decode address from JSON for Ed25519 address: %w", err)
+		// the address might be given in its bech32 form
+		_, bech32Addr, bech32Err := ParseBech32(j.Address)
+		if bech32Err != nil {
+			return nil, fmt.Errorf("unable to decode address from JSON for Ed25519 address: neither hex (%v) nor bech32: %w", err, bech32Err)
+		}
+		edAddr, ok := bech32Addr.(*Ed25519Address)
+		if !ok {
+			return nil, fmt.Errorf("unable to decode address from JSON for Ed25519 address: bech32 address is of type %d: %w", bech32Addr.Type(), ErrUnknownAddrType)
+		}
+		return edAddr, nil
 	}
 	if err := checkExactByteLength(len(addrBytes), Ed25519AddressBytesLength); err != nil {
 		return nil, fmt.Errorf("unable to decode address from JSON for Ed25519 address: %w", err)
@@ -2,10 +2,13 @@ package iotago_test
 
 import (
 	"errors"
+	"fmt"
 	"github.com/iotaledger/iota.go/v2/tpkg"
 	"testing"
 
 	"github.com/iotaledger/iota.go/v2"
+	"github.com/iotaledger/iota.go/v2/bech32"
+	"github.com/iotaledger/iota.go/v2/ed25519"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -106,3 +109,61 @@ func TestParseBech32(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBech32Variant(t *testing.T) {
+	for _, tt := range bech32Tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bech32m, err := iotago.EncodeBech32(tt.network, tt.addr, bech32.Bech32m)
+			assert.NoError(t, err)
+			assert.NotEqual(t, tt.bech32, bech32m)
+
+			network, addr, err := iotago.ParseBech32Variant(bech32m, bech32.Bech32m)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.network, network)
+			assert.Equal(t, tt.addr, addr)
+
+			_, _, err = iotago.ParseBech32(bech32m)
+			assert.True(t, errors.Is(err, bech32.ErrChecksumVariantMismatch))
+			_, _, err = iotago.ParseBech32Variant(tt.bech32, bech32.Bech32m)
+			assert.True(t, errors.Is(err, bech32.ErrChecksumVariantMismatch))
+		})
+	}
+}
+
+func TestEd25519Address_MarshalJSONBech32(t *testing.T) {
+	for _, tt := range bech32Tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jsonData, err := tt.addr.(*iotago.Ed25519Address).MarshalJSONBech32(tt.network)
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf(`{"type":0,"address":%q}`, tt.bech32), string(jsonData))
+
+			addr := &iotago.Ed25519Address{}
+			assert.NoError(t, addr.UnmarshalJSON(jsonData))
+			assert.Equal(t, tt.addr, addr)
+
+			hexJSONData, err := tt.addr.MarshalJSON()
+			assert.NoError(t, err)
+			hexAddr := &iotago.Ed25519Address{}
+			assert.NoError(t, hexAddr.UnmarshalJSON(hexJSONData))
+			assert.Equal(t, tt.addr, hexAddr)
+		})
+	}
+
+	assert.Error(t, (&iotago.Ed25519Address{}).UnmarshalJSON([]byte(`{"type": 0, "address": "iota1invalid"}`)))
+}
+
+func TestEd25519Address_VerifiedBy(t *testing.T) {
+	prvKey := tpkg.RandEd25519PrivateKey()
+	addr := iotago.AddressFromEd25519PubKey(prvKey.Public().(ed25519.PublicKey))
+	msg := []byte("message")
+
+	sig, err := iotago.NewInMemoryAddressSigner(iotago.NewAddressKeysForEd25519Address(&addr, prvKey)).Sign(&addr, msg)
+	assert.NoError(t, err)
+	edSig := sig.(*iotago.Ed25519Signature)
+
+	assert.NoError(t, addr.VerifiedBy(edSig, msg))
+	assert.True(t, errors.Is(addr.VerifiedBy(edSig, []byte("other message")), iotago.ErrEd25519SignatureInvalid))
+
+	otherAddr, _ := tpkg.RandEd25519Address()
+	assert.True(t, errors.Is(otherAddr.VerifiedBy(edSig, msg), iotago.ErrEd25519PubKeyAndAddrMismatch))
+}
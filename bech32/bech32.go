@@ -20,6 +20,29 @@ var charset = newEncoding("qpzry9x8gf2tvdw0s3jn54khce6mua7l")
 // Encode encodes the String string and the src data as a Bech32 string.
 // It returns an error when the input is invalid.
 func Encode(hrp string, src []byte) (string, error) {
+	return EncodeGeneric(hrp, src)
+}
+
+// EncodeM encodes the human-readable part hrp and the src data as a Bech32m string.
+// It returns an error when the input is invalid.
+func EncodeM(hrp string, src []byte) (string, error) {
+	return EncodeVariant(hrp, src, Bech32m)
+}
+
+// EncodeGeneric encodes the arbitrary human-readable part hrp and the data as a Bech32 string.
+// The 8-bit data is regrouped into 5-bit groups before the checksum is computed.
+// It returns an error when the human-readable part is invalid or the resulting string would be overlong.
+func EncodeGeneric(hrp string, data []byte) (string, error) {
+	return EncodeVariant(hrp, data, Bech32)
+}
+
+// EncodeVariant encodes the human-readable part hrp and the src data as a string using the given checksum variant.
+// The 8-bit src data is regrouped into 5-bit groups before the checksum is computed.
+// It returns an error when the input is invalid.
+func EncodeVariant(hrp string, src []byte, variant ChecksumVariant) (string, error) {
+	if err := checkVariant(variant); err != nil {
+		return "", err
+	}
 	dataLen := base32.EncodedLen(len(src))
 	if len(hrp)+dataLen+checksumLength+1 > maxStringLength {
 		return "", fmt.Errorf("%w: String length=%d, data length=%d", ErrInvalidLength, len(hrp), dataLen)
@@ -43,7 +66,7 @@ func Encode(hrp string, src []byte) (string, error) {
 	// convert to base32 and add the checksum
 	data := make([]uint8, base32.EncodedLen(len(src))+checksumLength)
 	base32.Encode(data, src)
-	copy(data[dataLen:], bech32CreateChecksum(hrpLower, data[:dataLen]))
+	copy(data[dataLen:], bech32CreateChecksum(variant, hrpLower, data[:dataLen]))
 
 	// enc the data part using the charset
 	chars := charset.encode(data)
@@ -65,26 +88,67 @@ func Encode(hrp string, src []byte) (string, error) {
 // It returns an error when s does not represent a valid Bech32 encoding.
 // An SyntaxError is returned when the error can be matched to a certain position in s.
 func Decode(s string) (string, []byte, error) {
+	return DecodeGeneric(s)
+}
+
+// DecodeM decodes the Bech32m string s into its human-readable and data part.
+// It returns an error when s does not represent a valid Bech32m encoding.
+// An SyntaxError is returned when the error can be matched to a certain position in s.
+func DecodeM(s string) (string, []byte, error) {
+	return DecodeVariant(s, Bech32m)
+}
+
+// DecodeGeneric decodes the Bech32 string s with an arbitrary human-readable part into its human-readable and data part.
+// The 5-bit groups of the data part are regrouped into 8-bit bytes after the checksum has been verified.
+// It returns an error wrapping ErrInvalidChecksum, ErrMixedCase or ErrInvalidLength when s has an invalid checksum,
+// mixes upper and lower case or is overlong.
+func DecodeGeneric(s string) (hrp string, data []byte, err error) {
+	return DecodeVariant(s, Bech32)
+}
+
+// DecodeVariant decodes the string s into its human-readable and data part using the given checksum variant.
+// The 5-bit groups of the data part are regrouped into 8-bit bytes after the checksum has been verified.
+// It returns an error when s does not represent a valid encoding.
+// If s carries a valid checksum of another variant, the returned error wraps ErrChecksumVariantMismatch.
+// An SyntaxError is returned when the error can be matched to a certain position in s.
+func DecodeVariant(s string, variant ChecksumVariant) (string, []byte, error) {
+	if err := checkVariant(variant); err != nil {
+		return "", nil, err
+	}
+	hrp, dst, usedVariant, err := DecodeAny(s)
+	if err != nil {
+		return "", nil, err
+	}
+	if usedVariant != variant {
+		return "", nil, &SyntaxError{fmt.Errorf("%w: expected %s but got %s", ErrChecksumVariantMismatch, variant, usedVariant), len(s) - checksumLength}
+	}
+	return hrp, dst, nil
+}
+
+// DecodeAny decodes the string s into its human-readable and data part and reports the checksum variant used by s.
+// It returns an error when s does not represent a valid encoding of any of the supported checksum variants.
+// An SyntaxError is returned when the error can be matched to a certain position in s.
+func DecodeAny(s string) (string, []byte, ChecksumVariant, error) {
 	if len(s) > maxStringLength {
-		return "", nil, &SyntaxError{fmt.Errorf("%w: maximum length exceeded", ErrInvalidLength), maxStringLength}
+		return "", nil, 0, &SyntaxError{fmt.Errorf("%w: maximum length exceeded", ErrInvalidLength), maxStringLength}
 	}
 	// validate the separator
 	hrpLen := strings.LastIndex(s, string(separator))
 	if hrpLen == -1 {
-		return "", nil, ErrMissingSeparator
+		return "", nil, 0, ErrMissingSeparator
 	}
 	if hrpLen < 1 || hrpLen+checksumLength > len(s) {
-		return "", nil, &SyntaxError{fmt.Errorf("%w: invalid position", ErrInvalidSeparator), hrpLen}
+		return "", nil, 0, &SyntaxError{fmt.Errorf("%w: invalid position", ErrInvalidSeparator), hrpLen}
 	}
 	// validate characters in human-readable part
 	for i, c := range s[:hrpLen] {
 		if !isValidHRPChar(c) {
-			return "", nil, &SyntaxError{fmt.Errorf("%w: not US-ASCII character in human-readable part", ErrInvalidCharacter), i}
+			return "", nil, 0, &SyntaxError{fmt.Errorf("%w: not US-ASCII character in human-readable part", ErrInvalidCharacter), i}
 		}
 	}
 	// validate that the case of the entire string is consistent
 	if err := validateCase(s); err != nil {
-		return "", nil, err
+		return "", nil, 0, err
 	}
 
 	// convert everything to lower
@@ -95,12 +159,16 @@ func Decode(s string) (string, []byte, error) {
 	// decode the data part
 	data, err := charset.decode(chars)
 	if err != nil {
-		return "", nil, &SyntaxError{fmt.Errorf("%w: non-charset character in data part", ErrInvalidCharacter), hrpLen + 1 + len(data)}
+		return "", nil, 0, &SyntaxError{fmt.Errorf("%w: non-charset character in data part", ErrInvalidCharacter), hrpLen + 1 + len(data)}
 	}
 
-	// validate the checksum
-	if len(data) < checksumLength || !bech32VerifyChecksum(hrp, data) {
-		return "", nil, &SyntaxError{ErrInvalidChecksum, len(s) - checksumLength}
+	// validate the checksum and determine its variant
+	if len(data) < checksumLength {
+		return "", nil, 0, &SyntaxError{ErrInvalidChecksum, len(s) - checksumLength}
+	}
+	variant := bech32ChecksumVariant(hrp, data)
+	if err := checkVariant(variant); err != nil {
+		return "", nil, 0, &SyntaxError{ErrInvalidChecksum, len(s) - checksumLength}
 	}
 	data = data[:len(data)-checksumLength]
 
@@ -109,11 +177,20 @@ func Decode(s string) (string, []byte, error) {
 	if _, err := base32.Decode(dst, data); err != nil {
 		var e *base32.CorruptInputError
 		if errors.As(err, &e) {
-			return "", nil, &SyntaxError{e.Unwrap(), hrpLen + 1 + e.Offset}
+			return "", nil, 0, &SyntaxError{e.Unwrap(), hrpLen + 1 + e.Offset}
 		}
-		return "", nil, err
+		return "", nil, 0, err
+	}
+	return hrp, dst, variant, nil
+}
+
+func checkVariant(variant ChecksumVariant) error {
+	switch variant {
+	case Bech32, Bech32m:
+		return nil
+	default:
+		return fmt.Errorf("%w: %d", ErrUnknownChecksumVariant, variant)
 	}
-	return hrp, dst, nil
 }
 
 func isValidHRPChar(r rune) bool {
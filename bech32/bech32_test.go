@@ -195,6 +195,117 @@ func TestDecode(t *testing.T) {
 	}
 }
 
+func TestGeneric(t *testing.T) {
+	data := decodeHex("ffbbcdeb38bdab49ca307b9ac5a928398a418820")
+
+	s, err := EncodeGeneric("myprefix", data)
+	if assert.NoError(t, err) {
+		hrp, decoded, err := DecodeGeneric(s)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "myprefix", hrp)
+			assert.Equal(t, data, decoded)
+		}
+	}
+
+	// replace the last checksum character with the next one of the charset
+	invalidChecksum := s[:len(s)-1] + string(charset.enc[(charset.decMap[s[len(s)-1]]+1)%32])
+	_, _, err = DecodeGeneric(invalidChecksum)
+	assert.True(t, errors.Is(err, ErrInvalidChecksum))
+
+	_, _, err = DecodeGeneric("A" + s[1:])
+	assert.True(t, errors.Is(err, ErrMixedCase))
+
+	_, err = EncodeGeneric("myprefix", make([]byte, 64))
+	assert.True(t, errors.Is(err, ErrInvalidLength))
+}
+
+func TestVariantBech32m(t *testing.T) {
+	var tests = []*struct {
+		s       string
+		expHRP  string
+		expData []byte
+	}{
+		{
+			s:       "a1lqfn3a",
+			expHRP:  "a",
+			expData: []byte{},
+		},
+		{
+			s:       "?1v759aa",
+			expHRP:  "?",
+			expData: []byte{},
+		},
+		{
+			s:       "abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx",
+			expHRP:  "abcdef",
+			expData: decodeHex("ffbbcdeb38bdab49ca307b9ac5a928398a418820"),
+		},
+		{
+			s:       "split1checkupstagehandshakeupstreamerranterredcaperredlc445v",
+			expHRP:  "split",
+			expData: decodeHex("c5f38b70305f519bf66d85fb6cf03058f3dde463ecd7918f2dc743918f2d"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			hrp, data, err := DecodeVariant(tt.s, Bech32m)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expHRP, hrp)
+				assert.Equal(t, tt.expData, data)
+			}
+
+			s, err := EncodeVariant(tt.expHRP, tt.expData, Bech32m)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.s, s)
+			}
+
+			hrp, data, err = DecodeM(tt.s)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.expHRP, hrp)
+				assert.Equal(t, tt.expData, data)
+			}
+
+			s, err = EncodeM(tt.expHRP, tt.expData)
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.s, s)
+			}
+
+			_, _, variant, err := DecodeAny(tt.s)
+			if assert.NoError(t, err) {
+				assert.Equal(t, Bech32m, variant)
+			}
+
+			// a Bech32m checksum must not verify as a Bech32 checksum
+			_, _, err = DecodeVariant(tt.s, Bech32)
+			assert.True(t, errors.Is(err, ErrInvalidChecksum))
+			assert.True(t, errors.Is(err, ErrChecksumVariantMismatch))
+		})
+	}
+}
+
+func TestDecodeAny(t *testing.T) {
+	_, _, variant, err := DecodeAny("a12uel5l")
+	if assert.NoError(t, err) {
+		assert.Equal(t, Bech32, variant)
+	}
+
+	_, _, err = DecodeM("a12uel5l")
+	assert.True(t, errors.Is(err, ErrChecksumVariantMismatch))
+
+	_, _, _, err = DecodeAny("a12uel5m")
+	assert.True(t, errors.Is(err, ErrInvalidChecksum))
+	assert.False(t, errors.Is(err, ErrChecksumVariantMismatch))
+}
+
+func TestVariantUnknown(t *testing.T) {
+	_, err := EncodeVariant("a", []byte{}, 0)
+	assert.True(t, errors.Is(err, ErrUnknownChecksumVariant))
+
+	_, _, err = DecodeVariant("a12uel5l", 0)
+	assert.True(t, errors.Is(err, ErrUnknownChecksumVariant))
+}
+
 func decodeHex(s string) []byte {
 	dst, err := hex.DecodeString(s)
 	if err != nil {
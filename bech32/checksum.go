@@ -1,11 +1,34 @@
 package bech32
 
+import "fmt"
+
 var gen = []int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
 
+// ChecksumVariant defines the constant the checksum polymod is XOR-ed with.
+type ChecksumVariant int
+
+const (
+	// Bech32 is the checksum variant defined in BIP 173.
+	Bech32 ChecksumVariant = 1
+	// Bech32m is the checksum variant defined in BIP 350.
+	Bech32m ChecksumVariant = 0x2bc830a3
+)
+
+func (v ChecksumVariant) String() string {
+	switch v {
+	case Bech32:
+		return "bech32"
+	case Bech32m:
+		return "bech32m"
+	default:
+		return fmt.Sprintf("unknown checksum variant %d", int(v))
+	}
+}
+
 // For more details on the checksum calculation, please refer to BIP 173.
-func bech32CreateChecksum(hrp string, blocks []byte) []byte {
+func bech32CreateChecksum(variant ChecksumVariant, hrp string, blocks []byte) []byte {
 	values := append(bech32HrpExpand(hrp), blocks...)
-	polymod := bech32Polymod(append(values, []byte{0, 0, 0, 0, 0, 0}...)) ^ 1
+	polymod := bech32Polymod(append(values, []byte{0, 0, 0, 0, 0, 0}...)) ^ int(variant)
 	res := make([]byte, 6)
 	for i := range res {
 		res[i] = byte((polymod >> (5 * (5 - i))) & 31)
@@ -41,7 +64,8 @@ func bech32HrpExpand(s string) []byte {
 	return res
 }
 
-// For more details on the checksum verification, please refer to BIP 173.
-func bech32VerifyChecksum(hrp string, data []byte) bool {
-	return bech32Polymod(append(bech32HrpExpand(hrp), data...)) == 1
+// For more details on the checksum verification, please refer to BIP 173 and BIP 350.
+// The returned variant is only valid if it is one of the defined checksum variants.
+func bech32ChecksumVariant(hrp string, data []byte) ChecksumVariant {
+	return ChecksumVariant(bech32Polymod(append(bech32HrpExpand(hrp), data...)))
 }
@@ -1,6 +1,9 @@
 package bech32
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // Errors reported during bech32 decoding.
 var (
@@ -10,6 +13,11 @@ var (
 	ErrMixedCase        = errors.New("mixed case")
 	ErrInvalidCharacter = errors.New("invalid character")
 	ErrInvalidChecksum  = errors.New("invalid checksum")
+
+	ErrUnknownChecksumVariant = errors.New("unknown checksum variant")
+	// ErrChecksumVariantMismatch wraps ErrInvalidChecksum and is returned when the checksum is valid
+	// for a different variant than the requested one.
+	ErrChecksumVariantMismatch = fmt.Errorf("%w: checksum variant mismatch", ErrInvalidChecksum)
 )
 
 // A SyntaxError is a description of a Bech32 syntax error.
@@ -31,10 +31,14 @@ const (
 	PayloadLengthByteSize = UInt32ByteSize
 	// MinPayloadByteSize is the minimum size of a payload (together with its length denotation).
 	MinPayloadByteSize = UInt32ByteSize + OneByte
-	// TokenSupply is the IOTA token supply.
-	TokenSupply = 2_779_530_283_277_761
+	// MainnetTokenSupply is the IOTA token supply of the mainnet.
+	MainnetTokenSupply uint64 = 2_779_530_283_277_761
 )
 
+// TokenSupply is the token supply against which deposits and balances are validated.
+// Private networks with a different supply must set it before any validation takes place.
+var TokenSupply = MainnetTokenSupply
+
 // TypeDenotationType defines a type denotation.
 type TypeDenotationType byte
 
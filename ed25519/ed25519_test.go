@@ -37,6 +37,24 @@ func TestGenerateKey(t *testing.T) {
 	assert.Errorf(t, err, "calling GenerateKey(rand) from insufficient entropy is invalid")
 }
 
+func TestEqual(t *testing.T) {
+	public, private, err := ed25519.GenerateKey(bytes.NewReader(nullSeed))
+	require.NoError(t, err)
+
+	assert.True(t, public.Equal(ed25519.PublicKey(append([]byte{}, public...))))
+	assert.True(t, private.Equal(ed25519.PrivateKey(append([]byte{}, private...))))
+
+	// keys of a different length or type are never equal
+	assert.False(t, public.Equal(public[:ed25519.PublicKeySize-1]))
+	assert.False(t, private.Equal(private[:ed25519.PrivateKeySize-1]))
+	assert.False(t, public.Equal([]byte(public)))
+	assert.False(t, private.Equal(std.PrivateKey(private)))
+
+	modifiedPrivate := append(ed25519.PrivateKey{}, private...)
+	modifiedPrivate[0] ^= 1
+	assert.False(t, private.Equal(modifiedPrivate))
+}
+
 func TestSignVerify(t *testing.T) {
 	publicKey, privateKey, _ := ed25519.GenerateKey(bytes.NewReader(nullSeed))
 
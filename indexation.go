@@ -55,9 +55,8 @@ func (u *Indexation) Deserialize(data []byte, deSeriMode DeSerializationMode) (i
 		}, IndexationIndexMaxLength).
 		AbortIf(func(err error) error {
 			if deSeriMode.HasMode(DeSeriModePerformValidation) {
-				switch {
-				case len(u.Index) < IndexationIndexMinLength:
-					return fmt.Errorf("unable to deserialize indexation index: %w", ErrIndexationIndexUnderMinSize)
+				if err := ValidateIndexation(u); err != nil {
+					return fmt.Errorf("unable to deserialize indexation: %w", err)
 				}
 			}
 			return nil
@@ -72,11 +71,8 @@ func (u *Indexation) Serialize(deSeriMode DeSerializationMode) ([]byte, error) {
 	return NewSerializer().
 		AbortIf(func(err error) error {
 			if deSeriMode.HasMode(DeSeriModePerformValidation) {
-				switch {
-				case len(u.Index) > IndexationIndexMaxLength:
-					return fmt.Errorf("unable to serialize indexation index: %w", ErrIndexationIndexExceedsMaxSize)
-				case len(u.Index) < IndexationIndexMinLength:
-					return fmt.Errorf("unable to serialize indexation index: %w", ErrIndexationIndexUnderMinSize)
+				if err := ValidateIndexation(u); err != nil {
+					return fmt.Errorf("unable to serialize indexation: %w", err)
 				}
 				// we do not check the length of the data field as in any circumstance
 				// the max size it can take up is dependent on how big the enclosing
@@ -96,6 +92,20 @@ func (u *Indexation) Serialize(deSeriMode DeSerializationMode) ([]byte, error) {
 		Serialize()
 }
 
+// ValidateIndexation checks that the given Indexation's index length is within
+// IndexationIndexMinLength and IndexationIndexMaxLength. The returned error wraps
+// ErrIndexationIndexUnderMinSize or ErrIndexationIndexExceedsMaxSize and names the
+// observed length and the violated limit.
+func ValidateIndexation(idx *Indexation) error {
+	switch {
+	case len(idx.Index) > IndexationIndexMaxLength:
+		return fmt.Errorf("%w: index length %d, max %d", ErrIndexationIndexExceedsMaxSize, len(idx.Index), IndexationIndexMaxLength)
+	case len(idx.Index) < IndexationIndexMinLength:
+		return fmt.Errorf("%w: index length %d, min %d", ErrIndexationIndexUnderMinSize, len(idx.Index), IndexationIndexMinLength)
+	}
+	return nil
+}
+
 func (u *Indexation) MarshalJSON() ([]byte, error) {
 	jIndexation := &jsonIndexation{}
 	jIndexation.Type = int(IndexationPayloadTypeID)
@@ -0,0 +1,125 @@
+package iotago
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// IndexationChunkHeaderSize is the size of the header prefixing the data of an Indexation holding a chunk:
+	// 	sequence number + chunk count
+	IndexationChunkHeaderSize = UInt32ByteSize + UInt32ByteSize
+)
+
+var (
+	// ErrInvalidIndexationChunkSize gets returned when the max chunk size for SplitDataIntoIndexationMessages
+	// would produce messages exceeding MessageBinSerializedMaxSize.
+	ErrInvalidIndexationChunkSize = errors.New("invalid indexation chunk size")
+	// ErrInvalidIndexationChunk gets returned when messages can not be reassembled by ReassembleIndexationData.
+	ErrInvalidIndexationChunk = errors.New("invalid indexation chunk")
+)
+
+// MaxIndexationChunkSize returns the maximum amount of data bytes a message produced by SplitDataIntoIndexationMessages
+// for the given index can hold, assuming the message holds MaxParentsInAMessage parents.
+func MaxIndexationChunkSize(index string) int {
+	const msgOverhead = MessageNetworkIDLength + OneByte + MaxParentsInAMessage*MessageIDLength + PayloadLengthByteSize + UInt64ByteSize
+	const indexationOverhead = TypeDenotationByteSize + UInt16ByteSize + UInt32ByteSize + IndexationChunkHeaderSize
+	return MessageBinSerializedMaxSize - msgOverhead - indexationOverhead - len(index)
+}
+
+// SplitDataIntoIndexationMessages splits the given data into chunks of at most maxPerMessage bytes and wraps each
+// of them into an Indexation with the given index within a Message of the given network ID.
+// A non-positive maxPerMessage uses MaxIndexationChunkSize. Empty data results in a single message holding an empty chunk.
+//
+// The data of every Indexation is prefixed by the chunk's sequence number and the total count of chunks, which
+// ReassembleIndexationData uses to put the data back together. As the ID of a message depends on its nonce, the messages
+// are returned without parents and nonce: callers should attach them in order, using the ID of the previously attached
+// message as one of the parents of the next one, so that the chunks are chained within the Tangle.
+func SplitDataIntoIndexationMessages(index string, data []byte, networkID uint64, maxPerMessage int) ([]*Message, error) {
+	if err := ValidateIndexation(&Indexation{Index: []byte(index)}); err != nil {
+		return nil, err
+	}
+
+	maxChunkSize := MaxIndexationChunkSize(index)
+	switch {
+	case maxPerMessage <= 0:
+		maxPerMessage = maxChunkSize
+	case maxPerMessage > maxChunkSize:
+		return nil, fmt.Errorf("%w: %d bytes per message exceed the maximum of %d for index %q", ErrInvalidIndexationChunkSize, maxPerMessage, maxChunkSize, index)
+	}
+
+	chunkCount := (len(data) + maxPerMessage - 1) / maxPerMessage
+	if chunkCount == 0 {
+		chunkCount = 1
+	}
+
+	msgs := make([]*Message, chunkCount)
+	for i := range msgs {
+		start := i * maxPerMessage
+		end := start + maxPerMessage
+		if end > len(data) {
+			end = len(data)
+		}
+
+		chunk := make([]byte, IndexationChunkHeaderSize+end-start)
+		binary.LittleEndian.PutUint32(chunk, uint32(i))
+		binary.LittleEndian.PutUint32(chunk[UInt32ByteSize:], uint32(chunkCount))
+		copy(chunk[IndexationChunkHeaderSize:], data[start:end])
+
+		msgs[i] = &Message{
+			NetworkID: networkID,
+			Payload:   &Indexation{Index: []byte(index), Data: chunk},
+		}
+	}
+
+	return msgs, nil
+}
+
+// ReassembleIndexationData puts the data split by SplitDataIntoIndexationMessages back together.
+// The messages can be passed in any order but all chunks must be present exactly once and share the same index.
+func ReassembleIndexationData(msgs []*Message) ([]byte, error) {
+	if len(msgs) == 0 {
+		return nil, fmt.Errorf("%w: no messages given", ErrInvalidIndexationChunk)
+	}
+
+	var index string
+	chunks := make([][]byte, len(msgs))
+	for i, msg := range msgs {
+		indexation, ok := msg.Payload.(*Indexation)
+		if !ok {
+			return nil, fmt.Errorf("%w: message at pos %d does not hold an indexation payload", ErrInvalidIndexationChunk, i)
+		}
+
+		switch {
+		case i == 0:
+			index = string(indexation.Index)
+		case string(indexation.Index) != index:
+			return nil, fmt.Errorf("%w: message at pos %d has index %q instead of %q", ErrInvalidIndexationChunk, i, indexation.Index, index)
+		}
+
+		if len(indexation.Data) < IndexationChunkHeaderSize {
+			return nil, fmt.Errorf("%w: data of message at pos %d is smaller than the chunk header", ErrInvalidIndexationChunk, i)
+		}
+
+		seq := binary.LittleEndian.Uint32(indexation.Data)
+		chunkCount := binary.LittleEndian.Uint32(indexation.Data[UInt32ByteSize:])
+		switch {
+		case int(chunkCount) != len(msgs):
+			return nil, fmt.Errorf("%w: message at pos %d denotes %d chunks but %d messages were given", ErrInvalidIndexationChunk, i, chunkCount, len(msgs))
+		case seq >= chunkCount:
+			return nil, fmt.Errorf("%w: message at pos %d has sequence number %d out of %d chunks", ErrInvalidIndexationChunk, i, seq, chunkCount)
+		case chunks[seq] != nil:
+			return nil, fmt.Errorf("%w: message at pos %d holds the already seen chunk %d", ErrInvalidIndexationChunk, i, seq)
+		}
+
+		chunks[seq] = indexation.Data[IndexationChunkHeaderSize:]
+	}
+
+	var data []byte
+	for _, chunk := range chunks {
+		data = append(data, chunk...)
+	}
+
+	return data, nil
+}
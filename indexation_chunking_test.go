@@ -0,0 +1,84 @@
+package iotago_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota.go/v2"
+	"github.com/iotaledger/iota.go/v2/tpkg"
+)
+
+func TestSplitDataIntoIndexationMessages(t *testing.T) {
+	const index = "chunked"
+	maxChunkSize := iotago.MaxIndexationChunkSize(index)
+
+	tests := []struct {
+		name          string
+		data          []byte
+		maxPerMessage int
+		msgCount      int
+		err           error
+	}{
+		{"ok - empty data", []byte{}, 10, 1, nil},
+		{"ok - single chunk", tpkg.RandBytes(10), 10, 1, nil},
+		{"ok - multiple chunks", tpkg.RandBytes(25), 10, 3, nil},
+		{"ok - max chunk size", tpkg.RandBytes(2*maxChunkSize + 1), 0, 3, nil},
+		{"err - chunk size too big", tpkg.RandBytes(10), maxChunkSize + 1, 0, iotago.ErrInvalidIndexationChunkSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, err := iotago.SplitDataIntoIndexationMessages(index, tt.data, 1337, tt.maxPerMessage)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, msgs, tt.msgCount)
+
+			for _, msg := range msgs {
+				assert.EqualValues(t, 1337, msg.NetworkID)
+				msg.Parents = tpkg.SortedRand32BytArray(iotago.MaxParentsInAMessage)
+				_, err := msg.Serialize(iotago.DeSeriModePerformValidation)
+				require.NoError(t, err)
+			}
+
+			rand.Shuffle(len(msgs), func(i, j int) { msgs[i], msgs[j] = msgs[j], msgs[i] })
+			data, err := iotago.ReassembleIndexationData(msgs)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.data), len(data))
+			if len(tt.data) > 0 {
+				assert.Equal(t, tt.data, data)
+			}
+		})
+	}
+
+	_, err := iotago.SplitDataIntoIndexationMessages("", []byte{1}, 1337, 0)
+	assert.True(t, errors.Is(err, iotago.ErrIndexationIndexUnderMinSize))
+}
+
+func TestReassembleIndexationData(t *testing.T) {
+	msgs, err := iotago.SplitDataIntoIndexationMessages("chunked", tpkg.RandBytes(30), 1337, 10)
+	require.NoError(t, err)
+
+	_, err = iotago.ReassembleIndexationData(nil)
+	assert.True(t, errors.Is(err, iotago.ErrInvalidIndexationChunk))
+
+	_, err = iotago.ReassembleIndexationData(msgs[:2])
+	assert.True(t, errors.Is(err, iotago.ErrInvalidIndexationChunk))
+
+	_, err = iotago.ReassembleIndexationData([]*iotago.Message{msgs[0], msgs[1], msgs[1]})
+	assert.True(t, errors.Is(err, iotago.ErrInvalidIndexationChunk))
+
+	otherIndex := *msgs[2].Payload.(*iotago.Indexation)
+	otherIndex.Index = []byte("other")
+	_, err = iotago.ReassembleIndexationData([]*iotago.Message{msgs[0], msgs[1], {Payload: &otherIndex}})
+	assert.True(t, errors.Is(err, iotago.ErrInvalidIndexationChunk))
+
+	_, err = iotago.ReassembleIndexationData([]*iotago.Message{msgs[0], msgs[1], {}})
+	assert.True(t, errors.Is(err, iotago.ErrInvalidIndexationChunk))
+}
@@ -58,3 +58,27 @@ func TestIndexation_Serialize(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateIndexation(t *testing.T) {
+	tests := []struct {
+		name   string
+		index  []byte
+		err    error
+		errMsg string
+	}{
+		{"ok", []byte("index"), nil, ""},
+		{"empty index", []byte{}, iotago.ErrIndexationIndexUnderMinSize, "index length 0, min 1"},
+		{"index too long", tpkg.RandBytes(iotago.IndexationIndexMaxLength + 1), iotago.ErrIndexationIndexExceedsMaxSize, "index length 65, max 64"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := iotago.ValidateIndexation(&iotago.Indexation{Index: tt.index})
+			if tt.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, tt.err))
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
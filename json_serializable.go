@@ -11,6 +11,8 @@ var (
 )
 
 // JSONSerializable is an object which can return a Serializable.
+// The JSON representations of all objects are structs which are marshaled with their keys in the order
+// of the struct's fields, so that marshaling the same object always yields the same bytes.
 type JSONSerializable interface {
 	// ToSerializable returns the Serializable form of the JSONSerializable.
 	ToSerializable() (Serializable, error)
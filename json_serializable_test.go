@@ -3,11 +3,55 @@ package iotago_test
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/iota.go/v2"
+	"github.com/iotaledger/iota.go/v2/tpkg"
 )
 
+func TestJSONRoundTripEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		seri iotago.Serializable
+	}{
+		{"message", func() iotago.Serializable { msg, _ := tpkg.RandMessage(iotago.TransactionPayloadTypeID); return msg }()},
+		{"milestone", func() iotago.Serializable { ms, _ := tpkg.RandMilestone(nil); return ms }()},
+		{"indexation", func() iotago.Serializable { idx, _ := tpkg.RandIndexation(); return idx }()},
+		{"receipt", func() iotago.Serializable { r, _ := tpkg.RandReceipt(); return r }()},
+		{"migrated funds entry", func() iotago.Serializable { e, _ := tpkg.RandMigratedFundsEntry(); return e }()},
+		{"treasury transaction", func() iotago.Serializable { tx, _ := tpkg.RandTreasuryTransaction(); return tx }()},
+		{"ed25519 address", func() iotago.Serializable { addr, _ := tpkg.RandEd25519Address(); return addr }()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.NoError(t, tpkg.JSONRoundTripEqual(tt.seri))
+		})
+	}
+}
+
+func TestJSONDeterministicMarshaling(t *testing.T) {
+	for _, payloadType := range []uint32{iotago.TransactionPayloadTypeID, iotago.MilestonePayloadTypeID, iotago.IndexationPayloadTypeID} {
+		t.Run(fmt.Sprintf("payload type %d", payloadType), func(t *testing.T) {
+			msg, _ := tpkg.RandMessage(payloadType)
+
+			jsonBytes, err := json.Marshal(msg)
+			assert.NoError(t, err)
+			for i := 0; i < 10; i++ {
+				again, err := json.Marshal(msg)
+				assert.NoError(t, err)
+				assert.Equal(t, jsonBytes, again)
+			}
+
+			keyOrder := regexp.MustCompile(`^\{"networkId":"\d+","parentMessageIds":\[.*\],"payload":\{"type":\d+,.*\},"nonce":"\d+"\}$`)
+			assert.True(t, keyOrder.Match(jsonBytes), "unexpected key order: %s", jsonBytes)
+		})
+	}
+}
+
 func TestDynamicJSONArrayDeserialization(t *testing.T) {
 	jsonData := `{"array": [{"type": 0, "name": "Alice"}, {"type": 1, "color": "violet"}]}`
 
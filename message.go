@@ -29,6 +29,8 @@ const (
 var (
 	// ErrMessageExceedsMaxSize gets returned when a serialized message exceeds MessageBinSerializedMaxSize.
 	ErrMessageExceedsMaxSize = errors.New("message exceeds max size")
+	// ErrInvalidMessageIDHex gets returned when a hex encoded message ID is not valid hex or not MessageIDLength bytes long.
+	ErrInvalidMessageIDHex = errors.New("invalid hex encoded message ID")
 
 	// restrictions around parents within a message.
 	messageParentArrayRules = ArrayRules{
@@ -83,6 +85,33 @@ func MessageIDToHexString(msgID MessageID) string {
 	return hex.EncodeToString(msgID[:])
 }
 
+// MessageIDsFromHex converts the given hex encoded message IDs to MessageIDs.
+// An error wrapping ErrInvalidMessageIDHex and denoting the index of the offending entry
+// is returned if any of the given message IDs is invalid.
+func MessageIDsFromHex(hexes []string) (MessageIDs, error) {
+	msgIDs := make(MessageIDs, len(hexes))
+	for i, msgIDHex := range hexes {
+		msgIDBytes, err := hex.DecodeString(msgIDHex)
+		if err != nil {
+			return nil, fmt.Errorf("%w: message ID at index %d: %s", ErrInvalidMessageIDHex, i, err)
+		}
+		if len(msgIDBytes) != MessageIDLength {
+			return nil, fmt.Errorf("%w: message ID at index %d has length %d instead of %d", ErrInvalidMessageIDHex, i, len(msgIDBytes), MessageIDLength)
+		}
+		copy(msgIDs[i][:], msgIDBytes)
+	}
+	return msgIDs, nil
+}
+
+// MessageIDsToHex converts the given message IDs to their hex representation.
+func MessageIDsToHex(msgIDs MessageIDs) []string {
+	msgIDsHex := make([]string, len(msgIDs))
+	for i, msgID := range msgIDs {
+		msgIDsHex[i] = MessageIDToHexString(msgID)
+	}
+	return msgIDsHex
+}
+
 // MustMessageIDFromHexString converts the given message IDs from their hex
 // to MessageID representation.
 func MustMessageIDFromHexString(messageIDHex string) MessageID {
@@ -93,7 +122,7 @@ func MustMessageIDFromHexString(messageIDHex string) MessageID {
 	return msgID
 }
 
-// Message can carry a payload and references two other messages.
+// Message can carry a payload and references other messages as its parents.
 type Message struct {
 	// The network ID for which this message is meant for.
 	NetworkID uint64
@@ -105,6 +134,22 @@ type Message struct {
 	Nonce uint64
 }
 
+// ParentsHex returns the hex encoded message IDs of the parents of the Message.
+func (m *Message) ParentsHex() []string {
+	return MessageIDsToHex(m.Parents)
+}
+
+// SetParentsFromHex sets the parents of the Message from the given hex encoded message IDs.
+// The parents are left untouched if any of the given message IDs is invalid.
+func (m *Message) SetParentsFromHex(parentsHex []string) error {
+	parents, err := MessageIDsFromHex(parentsHex)
+	if err != nil {
+		return err
+	}
+	m.Parents = parents
+	return nil
+}
+
 // ID computes the ID of the Message.
 func (m *Message) ID() (*MessageID, error) {
 	data, err := m.Serialize(DeSeriModeNoValidation)
@@ -135,7 +180,7 @@ func (m *Message) POW() (float64, error) {
 
 func (m *Message) Deserialize(data []byte, deSeriMode DeSerializationMode) (int, error) {
 	if len(data) > MessageBinSerializedMaxSize {
-		return 0, fmt.Errorf("%w: size %d bytes", ErrMessageExceedsMaxSize, len(data))
+		return 0, fmt.Errorf("%w: size %d bytes exceeds the allowed maximum of %d bytes", ErrMessageExceedsMaxSize, len(data), MessageBinSerializedMaxSize)
 	}
 	return NewDeserializer(data).
 		AbortIf(func(err error) error {
@@ -160,6 +205,9 @@ func (m *Message) Deserialize(data []byte, deSeriMode DeSerializationMode) (int,
 			case IndexationPayloadTypeID:
 			case MilestonePayloadTypeID:
 			default:
+				if _, err := PayloadSelector(ty); err != nil {
+					return nil, err
+				}
 				return nil, fmt.Errorf("a message can only contain a transaction, indexation or milestone but got type ID %d: %w", ty, ErrUnsupportedPayloadType)
 			}
 			return PayloadSelector(ty)
@@ -197,7 +245,7 @@ func (m *Message) Serialize(deSeriMode DeSerializationMode) ([]byte, error) {
 		return nil, err
 	}
 	if len(data) > MessageBinSerializedMaxSize {
-		return nil, fmt.Errorf("%w: size %d bytes", ErrMessageExceedsMaxSize, len(data))
+		return nil, fmt.Errorf("%w: size %d bytes exceeds the allowed maximum of %d bytes", ErrMessageExceedsMaxSize, len(data), MessageBinSerializedMaxSize)
 	}
 	return data, nil
 }
@@ -205,10 +253,7 @@ func (m *Message) Serialize(deSeriMode DeSerializationMode) ([]byte, error) {
 func (m *Message) MarshalJSON() ([]byte, error) {
 	jMessage := &jsonMessage{}
 	jMessage.NetworkID = strconv.FormatUint(m.NetworkID, 10)
-	jMessage.Parents = make([]string, len(m.Parents))
-	for i, parent := range m.Parents {
-		jMessage.Parents[i] = hex.EncodeToString(parent[:])
-	}
+	jMessage.Parents = m.ParentsHex()
 	jMessage.Nonce = strconv.FormatUint(m.Nonce, 10)
 	if m.Payload != nil {
 		jsonPayload, err := m.Payload.MarshalJSON()
@@ -285,14 +330,9 @@ func (jm *jsonMessage) ToSerializable() (Serializable, error) {
 	}
 	m.Nonce = parsedNonce
 
-	m.Parents = make(MessageIDs, len(jm.Parents))
-	for i, jparent := range jm.Parents {
-		parentBytes, err := hex.DecodeString(jparent)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode hex parent %d from JSON: %w", i+1, err)
-		}
-
-		copy(m.Parents[i][:], parentBytes)
+	m.Parents, err = MessageIDsFromHex(jm.Parents)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode hex parents from JSON: %w", err)
 	}
 
 	if jm.Payload != nil {
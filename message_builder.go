@@ -55,6 +55,7 @@ func (mb *MessageBuilder) Payload(seri Serializable) *MessageBuilder {
 	case *Indexation:
 	case *Milestone:
 	case *Transaction:
+	case *RawPayload:
 	case nil:
 	default:
 		mb.err = fmt.Errorf("%w: unsupported type %T", ErrUnknownPayloadType, seri)
@@ -64,8 +65,8 @@ func (mb *MessageBuilder) Payload(seri Serializable) *MessageBuilder {
 	return mb
 }
 
-// Tips uses the given NodeHTTPAPIClient to query for parents to use.
-func (mb *MessageBuilder) Tips(ctx context.Context, nodeAPI *NodeHTTPAPIClient) *MessageBuilder {
+// Tips uses the given NodeAPIClient to query for parents to use.
+func (mb *MessageBuilder) Tips(ctx context.Context, nodeAPI NodeAPIClient) *MessageBuilder {
 	if mb.err != nil {
 		return mb
 	}
@@ -116,6 +117,7 @@ func (mb *MessageBuilder) ParentsMessageIDs(parents MessageIDs) *MessageBuilder
 // ProofOfWork does the proof-of-work needed in order to satisfy the given target score.
 // It can be cancelled by cancelling the given context. This function should appear
 // as the last step before Build.
+// If numWorkers is not given, pow.OptimalWorkerCount workers are used.
 func (mb *MessageBuilder) ProofOfWork(ctx context.Context, targetScore float64, numWorkers ...int) *MessageBuilder {
 	if mb.err != nil {
 		return mb
@@ -128,6 +130,9 @@ func (mb *MessageBuilder) ProofOfWork(ctx context.Context, targetScore float64,
 
 	// cut out the nonce
 	powRelevantData := msgData[:len(msgData)-UInt64ByteSize]
+	if len(numWorkers) == 0 {
+		numWorkers = []int{pow.OptimalWorkerCount()}
+	}
 	worker := pow.New(numWorkers...)
 	nonce, err := worker.Mine(ctx, powRelevantData, targetScore)
 	if err != nil {
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/iotaledger/iota.go/v2"
+	"github.com/iotaledger/iota.go/v2/pow"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -36,6 +37,9 @@ func TestMessage_Deserialize(t *testing.T) {
 			msgPayload, msgPayloadData := tpkg.RandMessage(iotago.IndexationPayloadTypeID)
 			return test{"ok - indexation payload", msgPayloadData, msgPayload, nil}
 		}(),
+		func() test {
+			return test{"err - exceeds max size", make([]byte, iotago.MessageBinSerializedMaxSize+1), nil, iotago.ErrMessageExceedsMaxSize}
+		}(),
 	}
 
 	for _, tt := range tests {
@@ -78,6 +82,109 @@ func TestMessage_Serialize(t *testing.T) {
 	}
 }
 
+func TestMessage_SerializeExceedsMaxSize(t *testing.T) {
+	msg := &iotago.Message{
+		Parents: iotago.MessageIDs{tpkg.Rand32ByteArray()},
+		Payload: &iotago.Indexation{
+			Index: []byte("index"),
+			Data:  tpkg.RandBytes(iotago.MessageBinSerializedMaxSize),
+		},
+	}
+	_, err := msg.Serialize(iotago.DeSeriModePerformValidation)
+	assert.True(t, errors.Is(err, iotago.ErrMessageExceedsMaxSize))
+}
+
+func TestMessage_ParentsHex(t *testing.T) {
+	parents := tpkg.SortedRand32BytArray(iotago.MaxParentsInAMessage)
+	parentsHex := make([]string, len(parents))
+	for i, parent := range parents {
+		parentsHex[i] = iotago.MessageIDToHexString(parent)
+	}
+
+	msg := &iotago.Message{NetworkID: 1, Nonce: 1337}
+	assert.NoError(t, msg.SetParentsFromHex(parentsHex))
+	assert.Equal(t, parents, msg.Parents)
+	assert.Equal(t, parentsHex, msg.ParentsHex())
+
+	msgData, err := msg.Serialize(iotago.DeSeriModePerformValidation)
+	assert.NoError(t, err)
+
+	deserializedMsg := &iotago.Message{}
+	bytesRead, err := deserializedMsg.Deserialize(msgData, iotago.DeSeriModePerformValidation)
+	assert.NoError(t, err)
+	assert.Equal(t, len(msgData), bytesRead)
+	assert.EqualValues(t, msg, deserializedMsg)
+	assert.Equal(t, parentsHex, deserializedMsg.ParentsHex())
+
+	err = msg.SetParentsFromHex([]string{parentsHex[0], "zz"})
+	assert.True(t, errors.Is(err, iotago.ErrInvalidMessageIDHex))
+	err = msg.SetParentsFromHex([]string{parentsHex[0][:62]})
+	assert.True(t, errors.Is(err, iotago.ErrInvalidMessageIDHex))
+	assert.Equal(t, parents, msg.Parents)
+}
+
+func TestMessageIDsFromHex(t *testing.T) {
+	msgIDs := iotago.MessageIDs{tpkg.Rand32ByteArray(), tpkg.Rand32ByteArray(), tpkg.Rand32ByteArray()}
+	msgIDsHex := iotago.MessageIDsToHex(msgIDs)
+	assert.Len(t, msgIDsHex, len(msgIDs))
+	assert.Equal(t, iotago.MessageIDToHexString(msgIDs[1]), msgIDsHex[1])
+
+	decoded, err := iotago.MessageIDsFromHex(msgIDsHex)
+	assert.NoError(t, err)
+	assert.Equal(t, msgIDs, decoded)
+
+	_, err = iotago.MessageIDsFromHex([]string{msgIDsHex[0], msgIDsHex[1], "zz"})
+	assert.True(t, errors.Is(err, iotago.ErrInvalidMessageIDHex))
+	assert.Contains(t, err.Error(), "index 2")
+
+	_, err = iotago.MessageIDsFromHex([]string{msgIDsHex[0][:62]})
+	assert.True(t, errors.Is(err, iotago.ErrInvalidMessageIDHex))
+	assert.Contains(t, err.Error(), "index 0")
+}
+
+func TestMessage_TolerateUnknownPayload(t *testing.T) {
+	rawPayload := &iotago.RawPayload{Type: 1337, Data: tpkg.RandBytes(100)}
+	msg := &iotago.Message{
+		NetworkID: 1,
+		Parents:   tpkg.SortedRand32BytArray(2),
+		Payload:   rawPayload,
+		Nonce:     1337,
+	}
+	msgData, err := msg.Serialize(iotago.DeSeriModePerformValidation)
+	assert.NoError(t, err)
+
+	_, err = (&iotago.Message{}).Deserialize(msgData, iotago.DeSeriModePerformValidation)
+	assert.True(t, errors.Is(err, iotago.ErrUnknownPayloadType))
+
+	deserializedMsg := &iotago.Message{}
+	bytesRead, err := deserializedMsg.Deserialize(msgData, iotago.DeSeriModePerformValidation|iotago.DeSeriModeTolerateUnknownPayload)
+	assert.NoError(t, err)
+	assert.Equal(t, len(msgData), bytesRead)
+	assert.EqualValues(t, msg, deserializedMsg)
+
+	reserializedMsgData, err := deserializedMsg.Serialize(iotago.DeSeriModePerformValidation)
+	assert.NoError(t, err)
+	assert.Equal(t, msgData, reserializedMsgData)
+
+	// known payload types which are not allowed within a message are still rejected
+	receipt, _ := tpkg.RandReceipt()
+	receiptData, err := receipt.Serialize(iotago.DeSeriModeNoValidation)
+	assert.NoError(t, err)
+	msg.Payload = &iotago.RawPayload{Type: iotago.ReceiptPayloadTypeID, Data: receiptData[iotago.TypeDenotationByteSize:]}
+	msgData, err = msg.Serialize(iotago.DeSeriModeNoValidation)
+	assert.NoError(t, err)
+	_, err = (&iotago.Message{}).Deserialize(msgData, iotago.DeSeriModePerformValidation|iotago.DeSeriModeTolerateUnknownPayload)
+	assert.True(t, errors.Is(err, iotago.ErrUnsupportedPayloadType))
+}
+
+func TestMessage_POW(t *testing.T) {
+	msg, msgData := tpkg.RandMessage(iotago.IndexationPayloadTypeID)
+
+	powScore, err := msg.POW()
+	assert.NoError(t, err)
+	assert.Equal(t, pow.Score(msgData), powScore)
+}
+
 func TestMessage_UnmarshalJSON(t *testing.T) {
 	data := `
 		{
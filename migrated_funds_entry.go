@@ -3,7 +3,12 @@ package iotago
 import (
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+
+	legacy "github.com/iotaledger/iota.go/consts"
+	"github.com/iotaledger/iota.go/encoding/t5b1"
+	"github.com/iotaledger/iota.go/trinary"
 )
 
 const (
@@ -11,9 +16,81 @@ const (
 	MinMigratedFundsEntryDeposit = 1_000_000
 )
 
+var (
+	// ErrInvalidLegacyTailTransactionHash gets returned if a legacy tail transaction hash is not a valid 81-tryte hash.
+	ErrInvalidLegacyTailTransactionHash = errors.New("invalid legacy tail transaction hash")
+	// ErrMigratedFundsEntryDepositInvalid gets returned if a MigratedFundsEntry's deposit is below the minimum or above the token supply.
+	ErrMigratedFundsEntryDepositInvalid = errors.New("invalid migrated funds entry deposit")
+)
+
 // LegacyTailTransactionHash represents the bytes of a T5B1 encoded legacy tail transaction hash.
 type LegacyTailTransactionHash = [49]byte
 
+// LegacyTailTransactionHashFromTrytes converts the given 81-tryte legacy tail transaction hash
+// into its T5B1 encoded LegacyTailTransactionHash form.
+func LegacyTailTransactionHashFromTrytes(trytes trinary.Trytes) (LegacyTailTransactionHash, error) {
+	var tailTxHash LegacyTailTransactionHash
+	if len(trytes) != legacy.HashTrytesSize {
+		return tailTxHash, fmt.Errorf("%w: length must be %d but is %d", ErrInvalidLegacyTailTransactionHash, legacy.HashTrytesSize, len(trytes))
+	}
+	if err := trinary.ValidTrytes(trytes); err != nil {
+		return tailTxHash, fmt.Errorf("%w: %s", ErrInvalidLegacyTailTransactionHash, err)
+	}
+	copy(tailTxHash[:], t5b1.EncodeTrytes(trytes))
+	return tailTxHash, nil
+}
+
+// LegacyTailTransactionHashToTrytes converts the given T5B1 encoded LegacyTailTransactionHash
+// back into its 81-tryte legacy tail transaction hash form.
+func LegacyTailTransactionHashToTrytes(tailTxHash LegacyTailTransactionHash) (trinary.Trytes, error) {
+	trits := make(trinary.Trits, t5b1.DecodedLen(len(tailTxHash)))
+	if _, err := t5b1.Decode(trits, tailTxHash[:]); err != nil {
+		return "", fmt.Errorf("%w: %s", ErrInvalidLegacyTailTransactionHash, err)
+	}
+	// the encoding of a 243-trit hash is padded with zero trits
+	for _, trit := range trits[legacy.HashTrinarySize:] {
+		if trit != 0 {
+			return "", fmt.Errorf("%w: non-zero padding trits", ErrInvalidLegacyTailTransactionHash)
+		}
+	}
+	return trinary.MustTritsToTrytes(trits[:legacy.HashTrinarySize]), nil
+}
+
+// NewLegacyTailIndexation creates a new Indexation payload which archives the given data under the
+// T5B1 encoded form of the given legacy tail transaction hash, i.e. the same form a Receipt uses to reference it.
+func NewLegacyTailIndexation(legacyTailTrytes trinary.Trytes, data []byte) (*Indexation, error) {
+	tailTxHash, err := LegacyTailTransactionHashFromTrytes(legacyTailTrytes)
+	if err != nil {
+		return nil, err
+	}
+	return &Indexation{Index: tailTxHash[:], Data: data}, nil
+}
+
+// NewMigratedFundsEntry creates a new MigratedFundsEntry from the given legacy tail transaction hash, target address and deposit.
+// The deposit must be at least MinMigratedFundsEntryDeposit and must not exceed the TokenSupply.
+func NewMigratedFundsEntry(legacyTailTrytes trinary.Trytes, addr Address, deposit uint64) (*MigratedFundsEntry, error) {
+	tailTxHash, err := LegacyTailTransactionHashFromTrytes(legacyTailTrytes)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMigratedFundsEntryDeposit(deposit); err != nil {
+		return nil, err
+	}
+	return &MigratedFundsEntry{TailTransactionHash: tailTxHash, Address: addr, Deposit: deposit}, nil
+}
+
+// validateMigratedFundsEntryDeposit checks that the given deposit is at least MinMigratedFundsEntryDeposit
+// and does not exceed the TokenSupply.
+func validateMigratedFundsEntryDeposit(deposit uint64) error {
+	switch {
+	case deposit < MinMigratedFundsEntryDeposit:
+		return fmt.Errorf("%w: deposit %d is less than the minimum of %d", ErrMigratedFundsEntryDepositInvalid, deposit, MinMigratedFundsEntryDeposit)
+	case deposit > TokenSupply:
+		return fmt.Errorf("%w: deposit %d exceeds the total token supply", ErrMigratedFundsEntryDepositInvalid, deposit)
+	}
+	return nil
+}
+
 // MigratedFundsEntry are funds which were migrated from a legacy network.
 type MigratedFundsEntry struct {
 	// The tail transaction hash of the migration bundle.
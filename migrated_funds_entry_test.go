@@ -58,3 +58,73 @@ func TestMigratedFundsEntry_Serialize(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMigratedFundsEntry(t *testing.T) {
+	addr, _ := tpkg.RandEd25519Address()
+	validTailTrytes := tpkg.RandTrytes(81)
+
+	tests := []struct {
+		name       string
+		tailTrytes string
+		deposit    uint64
+		err        error
+	}{
+		{"ok", validTailTrytes, iotago.MinMigratedFundsEntryDeposit, nil},
+		{"err - tail hash too short", validTailTrytes[:80], iotago.MinMigratedFundsEntryDeposit, iotago.ErrInvalidLegacyTailTransactionHash},
+		{"err - tail hash invalid trytes", "a" + validTailTrytes[1:], iotago.MinMigratedFundsEntryDeposit, iotago.ErrInvalidLegacyTailTransactionHash},
+		{"err - deposit below minimum", validTailTrytes, iotago.MinMigratedFundsEntryDeposit - 1, iotago.ErrMigratedFundsEntryDepositInvalid},
+		{"err - deposit exceeds supply", validTailTrytes, iotago.TokenSupply + 1, iotago.ErrMigratedFundsEntryDepositInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, err := iotago.NewMigratedFundsEntry(tt.tailTrytes, addr, tt.deposit)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, addr, entry.Address)
+			assert.Equal(t, tt.deposit, entry.Deposit)
+
+			expectedTailTxHash, err := iotago.LegacyTailTransactionHashFromTrytes(tt.tailTrytes)
+			assert.NoError(t, err)
+			assert.Equal(t, expectedTailTxHash, entry.TailTransactionHash)
+		})
+	}
+}
+
+func TestLegacyTailTransactionHashToTrytes(t *testing.T) {
+	tailTrytes := tpkg.RandTrytes(81)
+
+	tailTxHash, err := iotago.LegacyTailTransactionHashFromTrytes(tailTrytes)
+	assert.NoError(t, err)
+
+	decoded, err := iotago.LegacyTailTransactionHashToTrytes(tailTxHash)
+	assert.NoError(t, err)
+	assert.Equal(t, tailTrytes, decoded)
+
+	// the last byte holds two padding trits which must be zero
+	tailTxHash[48] = 121
+	_, err = iotago.LegacyTailTransactionHashToTrytes(tailTxHash)
+	assert.True(t, errors.Is(err, iotago.ErrInvalidLegacyTailTransactionHash))
+}
+
+func TestNewLegacyTailIndexation(t *testing.T) {
+	tailTrytes := tpkg.RandTrytes(81)
+	data := []byte("bundle metadata")
+
+	indexation, err := iotago.NewLegacyTailIndexation(tailTrytes, data)
+	assert.NoError(t, err)
+
+	tailTxHash, err := iotago.LegacyTailTransactionHashFromTrytes(tailTrytes)
+	assert.NoError(t, err)
+	assert.Equal(t, tailTxHash[:], indexation.Index)
+	assert.Equal(t, data, indexation.Data)
+
+	_, err = indexation.Serialize(iotago.DeSeriModePerformValidation)
+	assert.NoError(t, err)
+
+	_, err = iotago.NewLegacyTailIndexation(tailTrytes[:80], data)
+	assert.True(t, errors.Is(err, iotago.ErrInvalidLegacyTailTransactionHash))
+}
@@ -62,12 +62,19 @@ var (
 	ErrMilestoneSignatureThresholdGreaterThanApplicablePublicKeySet = fmt.Errorf("the min. signature threshold must be less or equal the applicable public key set")
 	// ErrMilestoneInvalidSignature gets returned when a Milestone's signature is invalid.
 	ErrMilestoneInvalidSignature = fmt.Errorf("invalid milestone signature")
+	// ErrMilestoneInvalidPublicKeyHex gets returned when a hex encoded milestone public key is not valid hex or not MilestonePublicKeyLength bytes long.
+	ErrMilestoneInvalidPublicKeyHex = fmt.Errorf("invalid hex encoded milestone public key")
+	// ErrMilestoneSignaturePublicKeyOrderMismatch gets returned when a Milestone's signature is valid for another
+	// public key of the Milestone than the one at its position, i.e. the signatures were not produced in public key order.
+	ErrMilestoneSignaturePublicKeyOrderMismatch = fmt.Errorf("milestone signatures are not in the order of the public keys")
 	// ErrMilestoneInMemorySignerPrivateKeyMissing gets returned when an InMemoryEd25519MilestoneSigner is missing a private key.
 	ErrMilestoneInMemorySignerPrivateKeyMissing = fmt.Errorf("private key missing")
 	// ErrMilestoneDuplicatedPublicKey gets returned when a Milestone contains duplicated public keys.
 	ErrMilestoneDuplicatedPublicKey = fmt.Errorf("milestone contains duplicated public keys")
 	// ErrMilestoneInvalidMinPoWScoreValues gets returned when the min. PoW score fields are invalid.
 	ErrMilestoneInvalidMinPoWScoreValues = fmt.Errorf("invalid milestone min pow score values")
+	// ErrMilestoneMessageParentsMismatch gets returned when the parents of a Message enclosing a Milestone differ from the Milestone's parents.
+	ErrMilestoneMessageParentsMismatch = fmt.Errorf("message parents do not match the milestone parents")
 
 	// restrictions around parents within a Milestone.
 	milestoneParentArrayRules = ArrayRules{
@@ -109,6 +116,25 @@ type (
 	MilestoneInclusionMerkleProof = [MilestoneInclusionMerkleProofLength]byte
 )
 
+// MilestonePublicKeySetFromHex creates a MilestonePublicKeySet out of the given hex encoded public keys,
+// for example the coordinator public keys applicable for a given milestone index.
+func MilestonePublicKeySetFromHex(pubKeysHex ...string) (MilestonePublicKeySet, error) {
+	pubKeySet := make(MilestonePublicKeySet, len(pubKeysHex))
+	for i, pubKeyHex := range pubKeysHex {
+		pubKeyBytes, err := hex.DecodeString(pubKeyHex)
+		if err != nil {
+			return nil, fmt.Errorf("%w: public key at index %d: %s", ErrMilestoneInvalidPublicKeyHex, i, err)
+		}
+		if len(pubKeyBytes) != MilestonePublicKeyLength {
+			return nil, fmt.Errorf("%w: public key at index %d has length %d instead of %d", ErrMilestoneInvalidPublicKeyHex, i, len(pubKeyBytes), MilestonePublicKeyLength)
+		}
+		var pubKey MilestonePublicKey
+		copy(pubKey[:], pubKeyBytes)
+		pubKeySet[pubKey] = struct{}{}
+	}
+	return pubKeySet, nil
+}
+
 // NewMilestone creates a new Milestone. It automatically orders the given public keys by their byte order.
 func NewMilestone(index uint32, timestamp uint64, parents MilestoneParentMessageIDs, inclMerkleProof MilestoneInclusionMerkleProof, pubKeys []MilestonePublicKey) (*Milestone, error) {
 	ms := &Milestone{
@@ -121,7 +147,10 @@ func NewMilestone(index uint32, timestamp uint64, parents MilestoneParentMessage
 	if len(pubKeys) < MinPublicKeysInAMilestone {
 		return nil, ErrMilestoneTooFewPublicKeys
 	}
-	// auto. sort given public keys
+	// auto. sort given parents and public keys
+	sort.Slice(ms.Parents, func(i, j int) bool {
+		return bytes.Compare(ms.Parents[i][:], ms.Parents[j][:]) < 0
+	})
 	sort.Slice(ms.PublicKeys, func(i, j int) bool {
 		return bytes.Compare(ms.PublicKeys[i][:], ms.PublicKeys[j][:]) < 0
 	})
@@ -160,6 +189,31 @@ func (m *Milestone) ID() (*MilestoneID, error) {
 	return &h, nil
 }
 
+// AsMessage returns the Message enclosing the Milestone with the given network ID and parents,
+// for example to compute the ID of the message carrying the Milestone. If either the given parents
+// or the Milestone's parents are empty, the other ones are used, otherwise both must be the same set of parents.
+// The returned Message's nonce is not set, thus its proof of work still needs to be done.
+func (m *Milestone) AsMessage(networkID uint64, parents MessageIDs) (*Message, error) {
+	msParents := RemoveDupsAndSortByLexicalOrderArrayOf32Bytes(m.Parents)
+	msgParents := RemoveDupsAndSortByLexicalOrderArrayOf32Bytes(parents)
+
+	switch {
+	case len(msgParents) == 0:
+		msgParents = msParents
+	case len(msParents) != 0:
+		if len(msParents) != len(msgParents) {
+			return nil, fmt.Errorf("%w: milestone has %d parents but %d were given", ErrMilestoneMessageParentsMismatch, len(msParents), len(msgParents))
+		}
+		for i := range msParents {
+			if msParents[i] != msgParents[i] {
+				return nil, fmt.Errorf("%w: parent %s is not a milestone parent", ErrMilestoneMessageParentsMismatch, hex.EncodeToString(msgParents[i][:]))
+			}
+		}
+	}
+
+	return &Message{NetworkID: networkID, Parents: msgParents, Payload: m}, nil
+}
+
 // Essence returns the essence bytes (the bytes to be signed) of the Milestone.
 func (m *Milestone) Essence() ([]byte, error) {
 	essenceBytes, err := NewSerializer().
@@ -224,6 +278,8 @@ func (m *Milestone) VerifySignatures(minSigThreshold int, applicablePubKeys Mile
 		return fmt.Errorf("unable to compute milestone essence for signature verification: %w", err)
 	}
 
+	// public keys and signatures are public data, therefore neither the duplicate detection
+	// nor the lookup in the applicable public key set need to be constant time
 	seenPubKeys := make(map[MilestonePublicKey]int)
 	for msPubKeyIndex, msPubKey := range m.PublicKeys {
 		if prevIndex, ok := seenPubKeys[msPubKey]; ok {
@@ -235,6 +291,9 @@ func (m *Milestone) VerifySignatures(minSigThreshold int, applicablePubKeys Mile
 		}
 
 		if ok := ed25519.Verify(msPubKey[:], msEssence[:], m.Signatures[msPubKeyIndex][:]); !ok {
+			if otherIndex := m.signaturePublicKeyIndex(msEssence, msPubKeyIndex); otherIndex != -1 {
+				return fmt.Errorf("%w: signature at index %d was produced by public key at index %d", ErrMilestoneSignaturePublicKeyOrderMismatch, msPubKeyIndex, otherIndex)
+			}
 			return fmt.Errorf("%w: at index %d, checked against public key %s", ErrMilestoneInvalidSignature, msPubKeyIndex, hex.EncodeToString(msPubKey[:]))
 		}
 
@@ -244,11 +303,26 @@ func (m *Milestone) VerifySignatures(minSigThreshold int, applicablePubKeys Mile
 	return nil
 }
 
+// returns the index of the public key, other than the one at the given signature index, which produced
+// the signature at the given index or -1 if there is none.
+func (m *Milestone) signaturePublicKeyIndex(msEssence []byte, sigIndex int) int {
+	for i, pubKey := range m.PublicKeys {
+		if i == sigIndex {
+			continue
+		}
+		if ed25519.Verify(pubKey[:], msEssence, m.Signatures[sigIndex][:]) {
+			return i
+		}
+	}
+	return -1
+}
+
 // MilestoneSigningFunc is a function which produces a set of signatures for the given Milestone essence data.
 // The given public keys dictate in which order the returned signatures must occur.
 type MilestoneSigningFunc func(pubKeys []MilestonePublicKey, msEssence []byte) ([]MilestoneSignature, error)
 
 // InMemoryEd25519MilestoneSigner is a function which uses the provided Ed25519 MilestonePublicKeyMapping to produce signatures for the Milestone essence data.
+// The private keys are looked up by their public keys, which are not secret, so the lookup does not need to be constant time.
 func InMemoryEd25519MilestoneSigner(prvKeys MilestonePublicKeyMapping) MilestoneSigningFunc {
 	return func(pubKeys []MilestonePublicKey, msEssence []byte) ([]MilestoneSignature, error) {
 		sigs := make([]MilestoneSignature, len(pubKeys))
@@ -302,6 +376,8 @@ func InsecureRemoteEd25519MilestoneSigner(remoteEndpoint string) MilestoneSignin
 
 // Sign produces the signatures with the given envelope message and updates the Signatures field of the Milestone
 // with the resulting signatures of the given MilestoneSigningFunc.
+// The public keys must be finalized, i.e. be in lexical order, before calling Sign, as the signatures are
+// produced in the order of the public keys. Sign returns an error if the public keys are not in lexical order.
 func (m *Milestone) Sign(signingFunc MilestoneSigningFunc) error {
 	msEssence, err := m.Essence()
 	if err != nil {
@@ -326,6 +402,34 @@ func (m *Milestone) Sign(signingFunc MilestoneSigningFunc) error {
 	return nil
 }
 
+// SetSignatures sets the Signatures of the Milestone to the given signatures, for example when they were
+// produced by an external source instead of Sign. The signatures must be in the order of the public keys
+// and there must be exactly one signature per public key, which therefore must be sorted in lexical order
+// and free of duplicates.
+func (m *Milestone) SetSignatures(sigs []MilestoneSignature) error {
+	if err := milestonePublicKeyArrayRules.CheckBounds(uint16(len(m.PublicKeys))); err != nil {
+		return fmt.Errorf("unable to set milestone signatures: invalid public keys: %w", err)
+	}
+	pubKeyValidator := milestonePublicKeyArrayRules.ElementValidationFunc(milestonePublicKeyArrayRules.ValidationMode)
+	for i := range m.PublicKeys {
+		if err := pubKeyValidator(i, m.PublicKeys[i][:]); err != nil {
+			return fmt.Errorf("unable to set milestone signatures: invalid public keys: %w", err)
+		}
+	}
+
+	switch {
+	case len(sigs) < MinSignaturesInAMilestone:
+		return ErrMilestoneTooFewSignatures
+	case len(sigs) > MaxSignaturesInAMilestone:
+		return ErrMilestoneTooManySignatures
+	case len(sigs) != len(m.PublicKeys):
+		return fmt.Errorf("%w: %d public keys but %d signatures", ErrMilestoneSignaturesPublicKeyCountMismatch, len(m.PublicKeys), len(sigs))
+	}
+
+	m.Signatures = sigs
+	return nil
+}
+
 func (m *Milestone) Deserialize(data []byte, deSeriMode DeSerializationMode) (int, error) {
 	return NewDeserializer(data).
 		AbortIf(func(err error) error {
@@ -441,10 +545,7 @@ func (m *Milestone) MarshalJSON() ([]byte, error) {
 	jMilestone.Type = int(MilestonePayloadTypeID)
 	jMilestone.Index = int(m.Index)
 	jMilestone.Timestamp = int(m.Timestamp)
-	jMilestone.Parents = make([]string, len(m.Parents))
-	for i, parent := range m.Parents {
-		jMilestone.Parents[i] = hex.EncodeToString(parent[:])
-	}
+	jMilestone.Parents = MessageIDsToHex(m.Parents)
 	jMilestone.InclusionMerkleProof = hex.EncodeToString(m.InclusionMerkleProof[:])
 	jMilestone.NextPoWScore = int(m.NextPoWScore)
 	jMilestone.NextPoWScoreMilestoneIndex = int(m.NextPoWScoreMilestoneIndex)
@@ -505,13 +606,9 @@ func (j *jsonMilestone) ToSerializable() (Serializable, error) {
 	payload.Index = uint32(j.Index)
 	payload.Timestamp = uint64(j.Timestamp)
 
-	payload.Parents = make(MilestoneParentMessageIDs, len(j.Parents))
-	for i, jparent := range j.Parents {
-		parentBytes, err := hex.DecodeString(jparent)
-		if err != nil {
-			return nil, fmt.Errorf("unable to decode parent %d from JSON for milestone payload: %w", i+1, err)
-		}
-		copy(payload.Parents[i][:], parentBytes)
+	payload.Parents, err = MessageIDsFromHex(j.Parents)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode parents from JSON for milestone payload: %w", err)
 	}
 
 	inclusionMerkleProofBytes, err := hex.DecodeString(j.InclusionMerkleProof)
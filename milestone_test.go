@@ -1,8 +1,10 @@
 package iotago_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/iotaledger/iota.go/v2/tpkg"
 	"math/rand"
 	"sort"
@@ -65,6 +67,95 @@ func TestMilestone_Serialize(t *testing.T) {
 	}
 }
 
+func TestMilestone_ParentsValidation(t *testing.T) {
+	unorderedParents := tpkg.SortedRand32BytArray(3)
+	unorderedParents[0], unorderedParents[2] = unorderedParents[2], unorderedParents[0]
+
+	tests := []struct {
+		name    string
+		parents iotago.MilestoneParentMessageIDs
+		err     error
+	}{
+		{"ok - min parents", tpkg.SortedRand32BytArray(iotago.MinParentsInAMessage), nil},
+		{"ok - max parents", tpkg.SortedRand32BytArray(iotago.MaxParentsInAMessage), nil},
+		{"err - no parents", iotago.MilestoneParentMessageIDs{}, iotago.ErrArrayValidationMinElementsNotReached},
+		{"err - too many parents", tpkg.SortedRand32BytArray(iotago.MaxParentsInAMessage + 1), iotago.ErrArrayValidationMaxElementsExceeded},
+		{"err - unordered parents", unorderedParents, iotago.ErrArrayValidationOrderViolatesLexicalOrder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ms, _ := tpkg.RandMilestone(nil)
+			ms.Parents = tt.parents
+
+			_, err := ms.Serialize(iotago.DeSeriModePerformValidation)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+			} else {
+				assert.NoError(t, err)
+			}
+
+			msData, err := ms.Serialize(iotago.DeSeriModeNoValidation)
+			require.NoError(t, err)
+
+			_, err = (&iotago.Milestone{}).Deserialize(msData, iotago.DeSeriModePerformValidation)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMilestone_AsMessage(t *testing.T) {
+	parents := tpkg.SortedRand32BytArray(3)
+	ms, _ := tpkg.RandMilestone(parents)
+
+	reversedParents := iotago.MessageIDs{parents[2], parents[1], parents[0]}
+	for _, msgParents := range []iotago.MessageIDs{nil, parents, reversedParents} {
+		msg, err := ms.AsMessage(1337, msgParents)
+		require.NoError(t, err)
+		assert.EqualValues(t, 1337, msg.NetworkID)
+		assert.Equal(t, parents, msg.Parents)
+		assert.Equal(t, ms, msg.Payload)
+	}
+
+	_, err := ms.AsMessage(1337, parents[:2])
+	assert.True(t, errors.Is(err, iotago.ErrMilestoneMessageParentsMismatch))
+	_, err = ms.AsMessage(1337, iotago.MessageIDs{parents[0], parents[1], tpkg.Rand32ByteArray()})
+	assert.True(t, errors.Is(err, iotago.ErrMilestoneMessageParentsMismatch))
+
+	ms.Parents = nil
+	msg, err := ms.AsMessage(1337, reversedParents)
+	require.NoError(t, err)
+	assert.Equal(t, parents, msg.Parents)
+
+	msgID, err := msg.ID()
+	require.NoError(t, err)
+	assert.NotEqual(t, iotago.MessageID{}, *msgID)
+}
+
+func TestMilestone_SetSignatures(t *testing.T) {
+	ms := &iotago.Milestone{PublicKeys: tpkg.SortedRand32BytArray(2)}
+	sigs := []iotago.MilestoneSignature{tpkg.RandMilestoneSig(), tpkg.RandMilestoneSig()}
+
+	assert.True(t, errors.Is(ms.SetSignatures(nil), iotago.ErrMilestoneTooFewSignatures))
+	assert.True(t, errors.Is(ms.SetSignatures(sigs[:1]), iotago.ErrMilestoneSignaturesPublicKeyCountMismatch))
+	assert.True(t, errors.Is(ms.SetSignatures(make([]iotago.MilestoneSignature, iotago.MaxSignaturesInAMilestone+1)), iotago.ErrMilestoneTooManySignatures))
+	assert.Nil(t, ms.Signatures)
+
+	require.NoError(t, ms.SetSignatures(sigs))
+	assert.Equal(t, sigs, ms.Signatures)
+
+	// signatures are matched to the public keys by position, which requires the keys to be sorted
+	unsorted := &iotago.Milestone{PublicKeys: []iotago.MilestonePublicKey{ms.PublicKeys[1], ms.PublicKeys[0]}}
+	assert.True(t, errors.Is(unsorted.SetSignatures(sigs), iotago.ErrArrayValidationOrderViolatesLexicalOrder))
+	assert.Nil(t, unsorted.Signatures)
+
+	assert.True(t, errors.Is((&iotago.Milestone{}).SetSignatures(sigs), iotago.ErrArrayValidationMinElementsNotReached))
+}
+
 func TestMilestone_MarshalUnmarshalJSON(t *testing.T) {
 	ms := &iotago.Milestone{
 		Index:                1337,
@@ -194,6 +285,74 @@ func TestMilestoneSigning(t *testing.T) {
 				verificationErr: iotago.ErrMilestoneInvalidSignature,
 			}
 		}(),
+		func() test {
+			prvKey1 := tpkg.RandEd25519PrivateKey()
+			prvKey2 := tpkg.RandEd25519PrivateKey()
+			pubKey1 := pubKeyFromPrv(prvKey1)
+			pubKey2 := pubKeyFromPrv(prvKey2)
+
+			// public keys not in lexical order
+			pubKeys := iotago.LexicalOrdered32ByteArrays{pubKey1, pubKey2}
+			sort.Sort(sort.Reverse(pubKeys))
+
+			msPayload := &iotago.Milestone{
+				Parents:              tpkg.SortedRand32BytArray(1 + rand.Intn(7)),
+				Index:                1000,
+				Timestamp:            uint64(time.Now().Unix()),
+				PublicKeys:           pubKeys,
+				InclusionMerkleProof: tpkg.Rand32ByteArray(),
+			}
+
+			return test{
+				name: "err - public keys not finalized before signing",
+				ms:   msPayload,
+				signer: iotago.InMemoryEd25519MilestoneSigner(iotago.MilestonePublicKeyMapping{
+					pubKey1: prvKey1,
+					pubKey2: prvKey2,
+				}),
+				minSigThreshold: 2,
+				pubKeySet:       map[iotago.MilestonePublicKey]struct{}{pubKey1: {}, pubKey2: {}},
+				signingErr:      iotago.ErrArrayValidationOrderViolatesLexicalOrder,
+			}
+		}(),
+		func() test {
+			prvKey1 := tpkg.RandEd25519PrivateKey()
+			prvKey2 := tpkg.RandEd25519PrivateKey()
+			pubKey1 := pubKeyFromPrv(prvKey1)
+			pubKey2 := pubKeyFromPrv(prvKey2)
+
+			pubKeys := iotago.LexicalOrdered32ByteArrays{pubKey1, pubKey2}
+			sort.Sort(pubKeys)
+
+			msPayload := &iotago.Milestone{
+				Parents:              tpkg.SortedRand32BytArray(1 + rand.Intn(7)),
+				Index:                1000,
+				Timestamp:            uint64(time.Now().Unix()),
+				PublicKeys:           pubKeys,
+				InclusionMerkleProof: tpkg.Rand32ByteArray(),
+			}
+
+			inMemorySigner := iotago.InMemoryEd25519MilestoneSigner(iotago.MilestonePublicKeyMapping{
+				pubKey1: prvKey1,
+				pubKey2: prvKey2,
+			})
+
+			return test{
+				name: "err - signatures not in public key order",
+				ms:   msPayload,
+				signer: func(pubKeys []iotago.MilestonePublicKey, msEssence []byte) ([]iotago.MilestoneSignature, error) {
+					sigs, err := inMemorySigner(pubKeys, msEssence)
+					if err != nil {
+						return nil, err
+					}
+					sigs[0], sigs[1] = sigs[1], sigs[0]
+					return sigs, nil
+				},
+				minSigThreshold: 2,
+				pubKeySet:       map[iotago.MilestonePublicKey]struct{}{pubKey1: {}, pubKey2: {}},
+				verificationErr: iotago.ErrMilestoneSignaturePublicKeyOrderMismatch,
+			}
+		}(),
 	}
 
 	for _, test := range tests {
@@ -233,3 +392,89 @@ func TestNewMilestone(t *testing.T) {
 		Signatures:           nil,
 	}, ms)
 }
+
+func TestNewMilestone_DeterministicSerialization(t *testing.T) {
+	parents := tpkg.SortedRand32BytArray(8)
+	pubKeys := []iotago.MilestonePublicKey{tpkg.Rand32ByteArray(), tpkg.Rand32ByteArray(), tpkg.Rand32ByteArray()}
+	inclusionMerkleProof := tpkg.Rand32ByteArray()
+
+	var expected []byte
+	for i := 0; i < 100; i++ {
+		shuffledParents := append(iotago.MilestoneParentMessageIDs{}, parents...)
+		rand.Shuffle(len(shuffledParents), func(i, j int) {
+			shuffledParents[i], shuffledParents[j] = shuffledParents[j], shuffledParents[i]
+		})
+		shuffledPubKeys := append([]iotago.MilestonePublicKey{}, pubKeys...)
+		rand.Shuffle(len(shuffledPubKeys), func(i, j int) {
+			shuffledPubKeys[i], shuffledPubKeys[j] = shuffledPubKeys[j], shuffledPubKeys[i]
+		})
+
+		ms, err := iotago.NewMilestone(1000, 133713371337, shuffledParents, inclusionMerkleProof, shuffledPubKeys)
+		require.NoError(t, err)
+
+		essence, err := ms.Essence()
+		require.NoError(t, err)
+
+		if expected == nil {
+			expected = essence
+			continue
+		}
+		require.Equal(t, expected, essence)
+	}
+}
+
+func TestMilestoneFuzzingCrashers(t *testing.T) {
+	_, validMsData := tpkg.RandMilestone(nil)
+
+	type test struct {
+		name string
+		in   []byte
+	}
+	tests := []test{
+		{name: "empty", in: []byte{}},
+		{name: "only type", in: validMsData[:iotago.TypeDenotationByteSize]},
+		{name: "missing last signature byte", in: validMsData[:len(validMsData)-1]},
+		func() test {
+			// drop the last signature and adjust the signatures count to mismatch the public keys count
+			in := append([]byte{}, validMsData[:len(validMsData)-iotago.MilestoneSignatureLength]...)
+			in[len(in)-1-2*iotago.MilestoneSignatureLength]--
+			return test{name: "signatures and public keys count mismatch", in: in}
+		}(),
+	}
+
+	for i := 0; i < len(validMsData); i += 7 {
+		tests = append(tests, test{name: fmt.Sprintf("truncated to %d bytes", i), in: validMsData[:i]})
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, deSeriMode := range []iotago.DeSerializationMode{iotago.DeSeriModePerformValidation, iotago.DeSeriModeNoValidation} {
+				m := &iotago.Milestone{}
+				if _, err := m.Deserialize(tt.in, deSeriMode); err != nil {
+					continue
+				}
+
+				require.Error(t, m.VerifySignatures(1, iotago.MilestonePublicKeySet{}))
+
+				seriData, err := m.Serialize(deSeriMode)
+				require.NoError(t, err)
+				require.EqualValues(t, tt.in[:len(seriData)], seriData)
+			}
+		})
+	}
+}
+
+func TestMilestonePublicKeySetFromHex(t *testing.T) {
+	pubKey1, pubKey2 := tpkg.Rand32ByteArray(), tpkg.Rand32ByteArray()
+	pubKey1Hex, pubKey2Hex := hex.EncodeToString(pubKey1[:]), hex.EncodeToString(pubKey2[:])
+
+	pubKeySet, err := iotago.MilestonePublicKeySetFromHex(pubKey1Hex, pubKey2Hex, pubKey1Hex)
+	require.NoError(t, err)
+	require.Equal(t, iotago.MilestonePublicKeySet{pubKey1: {}, pubKey2: {}}, pubKeySet)
+
+	_, err = iotago.MilestonePublicKeySetFromHex(pubKey1Hex, "zz")
+	require.True(t, errors.Is(err, iotago.ErrMilestoneInvalidPublicKeyHex))
+
+	_, err = iotago.MilestonePublicKeySetFromHex(pubKey1Hex[:62])
+	require.True(t, errors.Is(err, iotago.ErrMilestoneInvalidPublicKeyHex))
+}
@@ -2,6 +2,7 @@ package iotago
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/hex"
 	"encoding/json"
@@ -9,10 +10,14 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 )
 
 var (
@@ -28,6 +33,16 @@ var (
 	ErrHTTPUnknownError = errors.New("unknown error")
 	// ErrHTTPNotImplemented gets returned for 501 not implemented error HTTP responses.
 	ErrHTTPNotImplemented = errors.New("operation not implemented/supported/available")
+	// ErrHTTPTooManyRequests gets returned for 429 too many requests error HTTP responses.
+	ErrHTTPTooManyRequests = errors.New("too many requests")
+	// ErrUnknownMessageEncoding gets returned when a Message should be submitted in an unknown MessageEncoding.
+	ErrUnknownMessageEncoding = errors.New("unknown message encoding")
+	// ErrBech32AddressNetworkMismatch gets returned when a Bech32 address does not belong to the network the NodeHTTPAPIClient is configured for.
+	ErrBech32AddressNetworkMismatch = errors.New("bech32 address network prefix mismatch")
+	// ErrMessageIDMismatch gets returned when response verification is enabled and the message returned by the node does not hash to the requested message ID.
+	ErrMessageIDMismatch = errors.New("message ID of the returned message does not match the requested message ID")
+	// ErrMilestoneIndexMismatch gets returned when response verification is enabled and the milestone returned by the node does not have the requested index.
+	ErrMilestoneIndexMismatch = errors.New("index of the returned milestone does not match the requested index")
 
 	httpCodeToErr = map[int]error{
 		http.StatusBadRequest:          ErrHTTPBadRequest,
@@ -35,6 +50,7 @@ var (
 		http.StatusNotFound:            ErrHTTPNotFound,
 		http.StatusUnauthorized:        ErrHTTPUnauthorized,
 		http.StatusNotImplemented:      ErrHTTPNotImplemented,
+		http.StatusTooManyRequests:     ErrHTTPTooManyRequests,
 	}
 )
 
@@ -42,6 +58,7 @@ const (
 	contentTypeJSON        = "application/json"
 	contentTypeOctetStream = "application/octet-stream"
 	locationHeader         = "Location"
+	contentEncodingGzip    = "gzip"
 )
 
 const (
@@ -126,10 +143,16 @@ const (
 	NodeAPIRoutePeers = "/api/v1/peers"
 )
 
+// DefaultNodeSyncTolerance defines the default amount of milestones the confirmed milestone index
+// of a node may lag behind its latest milestone index for the node to still be considered synced.
+const DefaultNodeSyncTolerance = 2
+
 // the default options applied to the NodeHTTPAPIClient.
 var defaultNodeAPIOptions = []NodeHTTPAPIClientOption{
 	WithNodeHTTPAPIClientHTTPClient(http.DefaultClient),
 	WithNodeHTTPAPIClientUserInfo(nil),
+	WithNodeHTTPAPIClientNetworkPrefix(""),
+	WithNodeHTTPAPIClientSyncTolerance(DefaultNodeSyncTolerance),
 }
 
 // NodeHTTPAPIClientOptions define options for the NodeHTTPAPIClient.
@@ -138,6 +161,20 @@ type NodeHTTPAPIClientOptions struct {
 	httpClient *http.Client
 	// The username and password information.
 	userInfo *url.Userinfo
+	// The network prefix Bech32 addresses passed to the client must have.
+	networkPrefix NetworkPrefix
+	// The amount of milestones a node may lag behind to still be considered synced.
+	syncTolerance uint32
+	// Whether concurrent identical GET requests are coalesced into one round trip.
+	singleFlight bool
+	// The maximum amount of requests per second, zero disables rate limiting.
+	rateLimit float64
+	// The maximum amount of requests which can be fired at once while rate limiting.
+	rateLimitBurst int
+	// The observer notified about every request, nil disables observation.
+	observer NodeHTTPAPIClientObserver
+	// Whether responses are verified against the requested message ID or milestone index.
+	responseVerification bool
 }
 
 // applies the given NodeHTTPAPIClientOption.
@@ -161,6 +198,66 @@ func WithNodeHTTPAPIClientUserInfo(userInfo *url.Userinfo) NodeHTTPAPIClientOpti
 	}
 }
 
+// WithNodeHTTPAPIClientNetworkPrefix sets the NetworkPrefix Bech32 addresses passed to the client are checked against.
+// An empty NetworkPrefix disables the check.
+func WithNodeHTTPAPIClientNetworkPrefix(networkPrefix NetworkPrefix) NodeHTTPAPIClientOption {
+	return func(opts *NodeHTTPAPIClientOptions) {
+		opts.networkPrefix = networkPrefix
+	}
+}
+
+// WithNodeHTTPAPIClientSyncTolerance sets the amount of milestones the confirmed milestone index of a node
+// may lag behind its latest milestone index for IsNodeSynced to still consider the node synced.
+func WithNodeHTTPAPIClientSyncTolerance(syncTolerance uint32) NodeHTTPAPIClientOption {
+	return func(opts *NodeHTTPAPIClientOptions) {
+		opts.syncTolerance = syncTolerance
+	}
+}
+
+// WithNodeHTTPAPIClientSingleFlight enables the coalescing of concurrent identical GET requests:
+// while a GET request to a route is in flight, further GET requests to the same route wait for
+// and share its response instead of issuing another round trip to the node.
+// Note that the shared round trip is bound to the context of the request which initiated it.
+func WithNodeHTTPAPIClientSingleFlight() NodeHTTPAPIClientOption {
+	return func(opts *NodeHTTPAPIClientOptions) {
+		opts.singleFlight = true
+	}
+}
+
+// WithNodeHTTPAPIClientRateLimit limits the client to fire at most rps requests per second with bursts
+// of up to burst requests. Every request waits for its turn before it is fired, respecting the cancellation
+// and deadline of the request's context while waiting. A non-positive rps disables rate limiting.
+func WithNodeHTTPAPIClientRateLimit(rps float64, burst int) NodeHTTPAPIClientOption {
+	return func(opts *NodeHTTPAPIClientOptions) {
+		opts.rateLimit = rps
+		opts.rateLimitBurst = burst
+	}
+}
+
+// WithNodeHTTPAPIClientObserver sets the NodeHTTPAPIClientObserver which gets notified about every request.
+func WithNodeHTTPAPIClientObserver(observer NodeHTTPAPIClientObserver) NodeHTTPAPIClientOption {
+	return func(opts *NodeHTTPAPIClientOptions) {
+		opts.observer = observer
+	}
+}
+
+// WithNodeHTTPAPIClientResponseVerification sets whether the responses of the node are verified against the request:
+// MessageByMessageID then checks that the returned message hashes to the requested message ID and
+// MilestoneByIndex checks that the returned milestone has the requested index.
+func WithNodeHTTPAPIClientResponseVerification(verify bool) NodeHTTPAPIClientOption {
+	return func(opts *NodeHTTPAPIClientOptions) {
+		opts.responseVerification = verify
+	}
+}
+
+// NodeHTTPAPIClientObserver observes the requests issued by a NodeHTTPAPIClient, for example to collect metrics.
+type NodeHTTPAPIClientObserver interface {
+	// ObserveRequest is called after a request to the given route completed, with the duration of the request
+	// including the time spent waiting for the rate limiter and reading the response, and the error of the request, if any.
+	// The route contains the concrete path parameters, such as message or output IDs.
+	ObserveRequest(method string, route string, duration time.Duration, err error)
+}
+
 // NodeHTTPAPIClientOption is a function setting a NodeHTTPAPIClient option.
 type NodeHTTPAPIClientOption func(opts *NodeHTTPAPIClientOptions)
 
@@ -171,10 +268,17 @@ func NewNodeHTTPAPIClient(baseURL string, opts ...NodeHTTPAPIClientOption) *Node
 	options.apply(defaultNodeAPIOptions...)
 	options.apply(opts...)
 
-	return &NodeHTTPAPIClient{
+	api := &NodeHTTPAPIClient{
 		BaseURL: baseURL,
 		opts:    options,
 	}
+	if options.singleFlight {
+		api.flights = &requestFlightGroup{calls: make(map[string]*requestFlight)}
+	}
+	if options.rateLimit > 0 {
+		api.limiter = newRequestRateLimiter(options.rateLimit, options.rateLimitBurst)
+	}
+	return api
 }
 
 // NodeHTTPAPIClient is a client for node HTTP REST API endpoints.
@@ -183,8 +287,81 @@ type NodeHTTPAPIClient struct {
 	BaseURL string
 	// holds the NodeHTTPAPIClient options.
 	opts *NodeHTTPAPIClientOptions
+	// coalesces concurrent identical GET requests if single flight is enabled.
+	flights *requestFlightGroup
+	// throttles requests if rate limiting is enabled.
+	limiter *requestRateLimiter
 }
 
+// NodeAPIClient is the set of node API calls provided by NodeHTTPAPIClient.
+// Code depending on it instead of on NodeHTTPAPIClient can be tested with a hand-written fake.
+type NodeAPIClient interface {
+	// Health returns whether the given node is healthy.
+	Health(ctx context.Context) (bool, error)
+	// Info gets the info of the node.
+	Info(ctx context.Context) (*NodeInfoResponse, error)
+	// IsNodeSynced returns whether the node is synced and its latest and confirmed milestone index.
+	IsNodeSynced(ctx context.Context) (bool, uint32, uint32, error)
+	// Tips gets the two tips from the node.
+	Tips(ctx context.Context) (*NodeTipsResponse, error)
+	// SubmitMessage submits the given Message to the node.
+	SubmitMessage(ctx context.Context, m *Message) (*Message, error)
+	// SubmitMessageAs submits the given Message to the node in the given MessageEncoding.
+	SubmitMessageAs(ctx context.Context, m *Message, encoding MessageEncoding) (*Message, error)
+	// MessageIDsByIndex gets message IDs filtered by index from the node.
+	MessageIDsByIndex(ctx context.Context, index []byte) (*MessageIDsByIndexResponse, error)
+	// MessageIDsByIndexes gets the message IDs for each of the given indexes from the node.
+	MessageIDsByIndexes(ctx context.Context, indexes [][]byte, concurrency int) (map[string]*MessageIDsByIndexResponse, error)
+	// MessageMetadataByMessageID gets the metadata of a message by its message ID from the node.
+	MessageMetadataByMessageID(ctx context.Context, msgID MessageID) (*MessageMetadataResponse, error)
+	// MessageByMessageID gets a message by its message ID from the node.
+	MessageByMessageID(ctx context.Context, msgID MessageID) (*Message, error)
+	// ChildrenByMessageID gets the children of a message by its message ID from the node.
+	ChildrenByMessageID(ctx context.Context, msgID MessageID) (*ChildrenResponse, error)
+	// OutputByID gets an output by its ID from the node.
+	OutputByID(ctx context.Context, utxoID UTXOInputID) (*NodeOutputResponse, error)
+	// OutputForInput fetches the output the given UTXOInput references and its deposit.
+	OutputForInput(ctx context.Context, input *UTXOInput) (Output, uint64, error)
+	// OutputsForInputs fetches the outputs the given UTXOInputs reference.
+	OutputsForInputs(ctx context.Context, inputs []*UTXOInput) (Outputs, error)
+	// BalanceByBech32Address returns the balance of a Bech32 address.
+	BalanceByBech32Address(ctx context.Context, bech32Addr string) (*AddressBalanceResponse, error)
+	// BalanceByEd25519Address returns the balance of an Ed25519 address.
+	BalanceByEd25519Address(ctx context.Context, addr *Ed25519Address) (*AddressBalanceResponse, error)
+	// OutputIDsByBech32Address gets the output IDs of a Bech32 address.
+	OutputIDsByBech32Address(ctx context.Context, bech32Addr string, includeSpentOutputs bool) (*AddressOutputsResponse, error)
+	// OutputsByBech32Address gets the outputs of a Bech32 address.
+	OutputsByBech32Address(ctx context.Context, bech32Addr string, includeSpentOutputs bool) (*AddressOutputsResponse, map[*UTXOInput]Output, error)
+	// OutputIDsByEd25519Address gets the output IDs of an Ed25519 address.
+	OutputIDsByEd25519Address(ctx context.Context, addr *Ed25519Address, includeSpentOutputs bool) (*AddressOutputsResponse, error)
+	// AllOutputIDsByEd25519Address gets all output IDs of an Ed25519 address.
+	AllOutputIDsByEd25519Address(ctx context.Context, addr *Ed25519Address, includeSpentOutputs bool) ([]OutputIDHex, error)
+	// OutputsByEd25519Address gets the outputs of an Ed25519 address.
+	OutputsByEd25519Address(ctx context.Context, addr *Ed25519Address, includeSpentOutputs bool) (*AddressOutputsResponse, map[*UTXOInput]Output, error)
+	// Treasury gets the current treasury.
+	Treasury(ctx context.Context) (*TreasuryResponse, error)
+	// Receipts gets all receipts persisted on the node.
+	Receipts(ctx context.Context) ([]*ReceiptTuple, error)
+	// ReceiptsByMigratedAtIndex gets all receipts for the given migrated at index persisted on the node.
+	ReceiptsByMigratedAtIndex(ctx context.Context, index uint32) ([]*ReceiptTuple, error)
+	// MilestoneByIndex gets a milestone by its index.
+	MilestoneByIndex(ctx context.Context, index uint32) (*MilestoneResponse, error)
+	// VerifyMilestone verifies the signatures of the milestone with the given index.
+	VerifyMilestone(ctx context.Context, index uint32, minSigThreshold int, applicablePubKeys MilestonePublicKeySet) error
+	// MilestoneUTXOChangesByIndex gets the UTXO changes of a milestone by its index.
+	MilestoneUTXOChangesByIndex(ctx context.Context, index uint32) (*MilestoneUTXOChangesResponse, error)
+	// PeerByID gets a peer by its identifier.
+	PeerByID(ctx context.Context, id string) (*PeerResponse, error)
+	// RemovePeerByID removes a peer by its identifier.
+	RemovePeerByID(ctx context.Context, id string) error
+	// Peers returns a list of all peers.
+	Peers(ctx context.Context) ([]*PeerResponse, error)
+	// AddPeer adds a new peer by libp2p multi address with optional alias.
+	AddPeer(ctx context.Context, multiAddress string, alias ...string) (*PeerResponse, error)
+}
+
+var _ NodeAPIClient = (*NodeHTTPAPIClient)(nil)
+
 // HTTPErrorResponseEnvelope defines the error response schema for node API responses.
 type HTTPErrorResponseEnvelope struct {
 	Error struct {
@@ -205,6 +382,36 @@ type RawDataEnvelope struct {
 	Data []byte
 }
 
+// gzipBody is a gzip decompressing response body which closes the underlying body.
+type gzipBody struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (b *gzipBody) Close() error {
+	_ = b.Reader.Close()
+	return b.body.Close()
+}
+
+// replaces the body of a gzip encoded response with a decompressing one.
+func decompressBody(res *http.Response) error {
+	if !strings.EqualFold(res.Header.Get("Content-Encoding"), contentEncodingGzip) {
+		return nil
+	}
+
+	gzipReader, err := gzip.NewReader(res.Body)
+	if err != nil {
+		return fmt.Errorf("unable to decompress gzip encoded response body: %w", err)
+	}
+
+	res.Body = &gzipBody{Reader: gzipReader, body: res.Body}
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+	return nil
+}
+
 func readBody(res *http.Response) ([]byte, error) {
 	resBody, err := ioutil.ReadAll(res.Body)
 	if err != nil {
@@ -259,6 +466,17 @@ func interpretBody(res *http.Response, decodeTo interface{}) error {
 }
 
 func (api *NodeHTTPAPIClient) Do(ctx context.Context, method string, route string, reqObj interface{}, resObj interface{}) (*http.Response, error) {
+	if api.opts.observer == nil {
+		return api.do(ctx, method, route, reqObj, resObj)
+	}
+
+	start := time.Now()
+	res, err := api.do(ctx, method, route, reqObj, resObj)
+	api.opts.observer.ObserveRequest(method, route, time.Since(start), err)
+	return res, err
+}
+
+func (api *NodeHTTPAPIClient) do(ctx context.Context, method string, route string, reqObj interface{}, resObj interface{}) (*http.Response, error) {
 	// marshal request object
 	var data []byte
 	var raw bool
@@ -293,6 +511,9 @@ func (api *NodeHTTPAPIClient) Do(ctx context.Context, method string, route strin
 		req.URL.User = api.opts.userInfo
 	}
 
+	// as the header is set explicitly, the http.Transport does not transparently decompress the response
+	req.Header.Set("Accept-Encoding", contentEncodingGzip)
+
 	if data != nil {
 		if !raw {
 			req.Header.Set("Content-Type", contentTypeJSON)
@@ -302,7 +523,29 @@ func (api *NodeHTTPAPIClient) Do(ctx context.Context, method string, route strin
 	}
 
 	// make the request
-	res, err := api.opts.httpClient.Do(req)
+	roundTrip := func() (*http.Response, error) {
+		if api.limiter != nil {
+			if err := api.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+		res, err := api.opts.httpClient.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if err := decompressBody(res); err != nil {
+			_ = res.Body.Close()
+			return nil, err
+		}
+		return res, nil
+	}
+
+	var res *http.Response
+	if api.flights != nil && method == http.MethodGet && reqObj == nil {
+		res, err = api.flights.do(ctx, route, roundTrip)
+	} else {
+		res, err = roundTrip()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -368,6 +611,18 @@ func (api *NodeHTTPAPIClient) Info(ctx context.Context) (*NodeInfoResponse, erro
 	return res, nil
 }
 
+// IsNodeSynced checks whether the node is synced by comparing its latest and confirmed milestone index.
+// The node is considered synced if the confirmed milestone index lags behind the latest milestone index
+// by at most the configured sync tolerance. The latest and confirmed milestone index are returned alongside.
+func (api *NodeHTTPAPIClient) IsNodeSynced(ctx context.Context) (bool, uint32, uint32, error) {
+	info, err := api.Info(ctx)
+	if err != nil {
+		return false, 0, 0, err
+	}
+	latest, confirmed := info.LatestMilestoneIndex, info.ConfirmedMilestoneIndex
+	return latest < confirmed || latest-confirmed <= api.opts.syncTolerance, latest, confirmed, nil
+}
+
 // NodeTipsResponse defines the response of a GET tips REST API call.
 type NodeTipsResponse struct {
 	// The hex encoded message IDs of the tips.
@@ -376,15 +631,7 @@ type NodeTipsResponse struct {
 
 // Tips returns the hex encoded tips as MessageIDs.
 func (ntr *NodeTipsResponse) Tips() (MessageIDs, error) {
-	msgIDs := make(MessageIDs, len(ntr.TipsHex))
-	for i, tip := range ntr.TipsHex {
-		msgID, err := hex.DecodeString(tip)
-		if err != nil {
-			return nil, err
-		}
-		copy(msgIDs[i][:], msgID)
-	}
-	return msgIDs, nil
+	return MessageIDsFromHex(ntr.TipsHex)
 }
 
 // Tips gets the two tips from the node.
@@ -397,19 +644,43 @@ func (api *NodeHTTPAPIClient) Tips(ctx context.Context) (*NodeTipsResponse, erro
 	return res, nil
 }
 
-// SubmitMessage submits the given Message to the node.
+// MessageEncoding defines the encoding in which a Message is submitted to the node.
+type MessageEncoding byte
+
+const (
+	// MessageEncodingBinary submits a Message in its binary serialized form.
+	MessageEncodingBinary MessageEncoding = iota
+	// MessageEncodingJSON submits a Message in its JSON form.
+	MessageEncodingJSON
+)
+
+// SubmitMessage submits the given Message to the node in its binary form.
 // The node will take care of filling missing information.
 // This function returns the finalized message created by the node.
 func (api *NodeHTTPAPIClient) SubmitMessage(ctx context.Context, m *Message) (*Message, error) {
-	// Do not check the message because the validation would fail if
-	// no parents were given. The node will first add this missing information and
-	// validate the message afterwards.
-	data, err := m.Serialize(DeSeriModeNoValidation)
-	if err != nil {
-		return nil, err
+	return api.SubmitMessageAs(ctx, m, MessageEncodingBinary)
+}
+
+// SubmitMessageAs works like SubmitMessage but submits the given Message in the given MessageEncoding.
+// Submitting the Message as JSON is useful when intermediaries do not forward binary bodies untouched.
+func (api *NodeHTTPAPIClient) SubmitMessageAs(ctx context.Context, m *Message, encoding MessageEncoding) (*Message, error) {
+	var req interface{}
+	switch encoding {
+	case MessageEncodingBinary:
+		// Do not check the message because the validation would fail if
+		// no parents were given. The node will first add this missing information and
+		// validate the message afterwards.
+		data, err := m.Serialize(DeSeriModeNoValidation)
+		if err != nil {
+			return nil, err
+		}
+		req = &RawDataEnvelope{Data: data}
+	case MessageEncodingJSON:
+		req = m
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnknownMessageEncoding, encoding)
 	}
 
-	req := &RawDataEnvelope{Data: data}
 	res, err := api.Do(ctx, http.MethodPost, NodeAPIRouteMessages, req, nil)
 	if err != nil {
 		return nil, err
@@ -456,6 +727,97 @@ func (api *NodeHTTPAPIClient) MessageIDsByIndex(ctx context.Context, index []byt
 	return res, nil
 }
 
+// MessageIDsByIndexesError holds the errors of the failed queries of MessageIDsByIndexes keyed by the hex encoded index.
+type MessageIDsByIndexesError map[string]error
+
+func (e MessageIDsByIndexesError) Error() string {
+	indexes := make([]string, 0, len(e))
+	for index := range e {
+		indexes = append(indexes, index)
+	}
+	sort.Strings(indexes)
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "%d index queries failed", len(e))
+	for _, index := range indexes {
+		fmt.Fprintf(&msg, "; index %s: %s", index, e[index])
+	}
+	return msg.String()
+}
+
+// MessageIDsByIndexes concurrently gets the message IDs for each of the given indexes from the node,
+// issuing at most concurrency queries at once. A non-positive concurrency queries all indexes at once.
+// The responses are keyed by the hex encoded index. A failed query does not abort the others:
+// the responses of the successful queries are returned together with a MessageIDsByIndexesError
+// holding the errors of the failed ones.
+func (api *NodeHTTPAPIClient) MessageIDsByIndexes(ctx context.Context, indexes [][]byte, concurrency int) (map[string]*MessageIDsByIndexResponse, error) {
+	if concurrency <= 0 || concurrency > len(indexes) {
+		concurrency = len(indexes)
+	}
+
+	responses := make(map[string]*MessageIDsByIndexResponse, len(indexes))
+	errs := MessageIDsByIndexesError{}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for _, index := range indexes {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(index []byte) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			res, err := api.MessageIDsByIndex(ctx, index)
+
+			mu.Lock()
+			defer mu.Unlock()
+			indexHex := hex.EncodeToString(index)
+			if err != nil {
+				errs[indexHex] = err
+				return
+			}
+			responses[indexHex] = res
+		}(index)
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return responses, errs
+	}
+
+	return responses, nil
+}
+
+// LedgerInclusionState defines the ledger inclusion state of a message's payload.
+type LedgerInclusionState string
+
+const (
+	// LedgerInclusionStateUnknown denotes that the ledger inclusion state is not (yet) known.
+	LedgerInclusionStateUnknown LedgerInclusionState = ""
+	// LedgerInclusionStateNoTransaction denotes that the message does not contain a transaction payload.
+	LedgerInclusionStateNoTransaction LedgerInclusionState = "noTransaction"
+	// LedgerInclusionStateIncluded denotes that the message's transaction payload was applied to the ledger.
+	LedgerInclusionStateIncluded LedgerInclusionState = "included"
+	// LedgerInclusionStateConflicting denotes that the message's transaction payload conflicts with the ledger.
+	LedgerInclusionStateConflicting LedgerInclusionState = "conflicting"
+)
+
+// ErrUnknownLedgerInclusionState gets returned when a ledger inclusion state can not be decoded.
+var ErrUnknownLedgerInclusionState = errors.New("unknown ledger inclusion state")
+
+// ParseLedgerInclusionState parses the given ledger inclusion state as returned by the node.
+// ErrUnknownLedgerInclusionState is returned if the given state is not a known one.
+func ParseLedgerInclusionState(state string) (LedgerInclusionState, error) {
+	switch parsed := LedgerInclusionState(state); parsed {
+	case LedgerInclusionStateNoTransaction, LedgerInclusionStateIncluded, LedgerInclusionStateConflicting:
+		return parsed, nil
+	default:
+		return LedgerInclusionStateUnknown, fmt.Errorf("%w: %s", ErrUnknownLedgerInclusionState, state)
+	}
+}
+
 // MessageMetadataResponse defines the response of a GET message metadata REST API call.
 type MessageMetadataResponse struct {
 	// The hex encoded message ID of the message.
@@ -478,6 +840,32 @@ type MessageMetadataResponse struct {
 	ConflictReason uint8 `json:"conflictReason,omitempty"`
 }
 
+// InclusionState returns the parsed LedgerInclusionState of the message.
+// LedgerInclusionStateUnknown is returned if the node did not provide a known state.
+func (mmr *MessageMetadataResponse) InclusionState() LedgerInclusionState {
+	if mmr.LedgerInclusionState == nil {
+		return LedgerInclusionStateUnknown
+	}
+	// unknown states are reported as LedgerInclusionStateUnknown by ParseLedgerInclusionState
+	state, _ := ParseLedgerInclusionState(*mmr.LedgerInclusionState)
+	return state
+}
+
+// Referenced tells whether the message is referenced by a milestone.
+func (mmr *MessageMetadataResponse) Referenced() bool {
+	return mmr.ReferencedByMilestoneIndex != nil
+}
+
+// PromotionNeeded tells whether the node advises to promote the message.
+func (mmr *MessageMetadataResponse) PromotionNeeded() bool {
+	return mmr.ShouldPromote != nil && *mmr.ShouldPromote
+}
+
+// ReattachmentNeeded tells whether the node advises to reattach the message.
+func (mmr *MessageMetadataResponse) ReattachmentNeeded() bool {
+	return mmr.ShouldReattach != nil && *mmr.ShouldReattach
+}
+
 // MessageMetadataByMessageID gets the metadata of a message by its message ID from the node.
 func (api *NodeHTTPAPIClient) MessageMetadataByMessageID(ctx context.Context, msgID MessageID) (*MessageMetadataResponse, error) {
 	query := fmt.Sprintf(NodeAPIRouteMessageMetadata, hex.EncodeToString(msgID[:]))
@@ -506,6 +894,17 @@ func (api *NodeHTTPAPIClient) MessageByMessageID(ctx context.Context, msgID Mess
 	if err != nil {
 		return nil, err
 	}
+
+	if api.opts.responseVerification {
+		resMsgID, err := msg.ID()
+		if err != nil {
+			return nil, fmt.Errorf("unable to compute message ID of the returned message: %w", err)
+		}
+		if *resMsgID != msgID {
+			return nil, fmt.Errorf("%w: requested %s, got %s", ErrMessageIDMismatch, hex.EncodeToString(msgID[:]), hex.EncodeToString(resMsgID[:]))
+		}
+	}
+
 	return msg, nil
 }
 
@@ -590,6 +989,63 @@ func (api *NodeHTTPAPIClient) OutputByID(ctx context.Context, utxoID UTXOInputID
 	return res, nil
 }
 
+// OutputForInput fetches the output the given UTXOInput references from the node and returns it together with its deposit.
+func (api *NodeHTTPAPIClient) OutputForInput(ctx context.Context, input *UTXOInput) (Output, uint64, error) {
+	res, err := api.OutputByID(ctx, input.ID())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	output, err := res.Output()
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to decode output for input %s: %w", input.ID().ToHex(), err)
+	}
+
+	deposit, err := output.Deposit()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return output, deposit, nil
+}
+
+// OutputsForInputs concurrently fetches the outputs the given UTXOInputs reference from the node.
+// The returned Outputs are in the same order as the given inputs.
+// If any of the queries fails, the first encountered error is returned.
+func (api *NodeHTTPAPIClient) OutputsForInputs(ctx context.Context, inputs []*UTXOInput) (Outputs, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	outputs := make(Outputs, len(inputs))
+
+	var firstErr error
+	var errOnce sync.Once
+	var wg sync.WaitGroup
+	wg.Add(len(inputs))
+	for i, input := range inputs {
+		go func(i int, input *UTXOInput) {
+			defer wg.Done()
+			output, _, err := api.OutputForInput(ctx, input)
+			if err != nil {
+				// cancel the remaining queries as the batch can't succeed anymore
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			outputs[i] = output
+		}(i, input)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return outputs, nil
+}
+
 // AddressBalanceResponse defines the response of a GET addresses REST API call.
 type AddressBalanceResponse struct {
 	// The type of the address.
@@ -604,25 +1060,49 @@ type AddressBalanceResponse struct {
 	LedgerIndex uint64 `json:"ledgerIndex"`
 }
 
+// parses the given Bech32 address and checks it against the configured network prefix.
+func (api *NodeHTTPAPIClient) parseBech32Address(bech32Addr string) (Address, error) {
+	prefix, addr, err := ParseBech32(bech32Addr)
+	if err != nil {
+		return nil, err
+	}
+	if api.opts.networkPrefix != "" && prefix != api.opts.networkPrefix {
+		return nil, fmt.Errorf("%w: expected %s but got %s", ErrBech32AddressNetworkMismatch, api.opts.networkPrefix, prefix)
+	}
+	return addr, nil
+}
+
 // BalanceByBech32Address returns the balance of the given Bech32 address.
+// The address must match the network prefix set via WithNodeHTTPAPIClientNetworkPrefix, if any.
+// A zero balance is returned if the node does not know the address.
 func (api *NodeHTTPAPIClient) BalanceByBech32Address(ctx context.Context, bech32Addr string) (*AddressBalanceResponse, error) {
+	addr, err := api.parseBech32Address(bech32Addr)
+	if err != nil {
+		return nil, err
+	}
+
 	query := fmt.Sprintf(NodeAPIRouteAddressBech32Balance, bech32Addr)
 
 	res := &AddressBalanceResponse{}
-	_, err := api.Do(ctx, http.MethodGet, query, nil, res)
-	if err != nil {
+	if _, err := api.Do(ctx, http.MethodGet, query, nil, res); err != nil {
+		if errors.Is(err, ErrHTTPNotFound) {
+			return &AddressBalanceResponse{AddressType: addr.Type(), Address: addr.String()}, nil
+		}
 		return nil, err
 	}
 	return res, nil
 }
 
 // BalanceByEd25519Address returns the balance of an Ed25519 address.
+// A zero balance is returned if the node does not know the address.
 func (api *NodeHTTPAPIClient) BalanceByEd25519Address(ctx context.Context, addr *Ed25519Address) (*AddressBalanceResponse, error) {
 	query := fmt.Sprintf(NodeAPIRouteAddressEd25519Balance, addr.String())
 
 	res := &AddressBalanceResponse{}
-	_, err := api.Do(ctx, http.MethodGet, query, nil, res)
-	if err != nil {
+	if _, err := api.Do(ctx, http.MethodGet, query, nil, res); err != nil {
+		if errors.Is(err, ErrHTTPNotFound) {
+			return &AddressBalanceResponse{AddressType: addr.Type(), Address: addr.String()}, nil
+		}
 		return nil, err
 	}
 
@@ -643,23 +1123,19 @@ type AddressOutputsResponse struct {
 	OutputIDs []OutputIDHex `json:"outputIDs"`
 	// The ledger index at which these outputs where available at.
 	LedgerIndex uint64 `json:"ledgerIndex"`
+	// The cursor to query the next page of output IDs.
+	// It is empty if there are no further pages or the node does not paginate the results.
+	Cursor string `json:"cursor,omitempty"`
 }
 
 // OutputIDsByBech32Address gets output IDs of outputs residing on the given Bech32 address.
 // Per default only unspent outputs IDs are returned. Set includeSpentOutputs to true to also return spent output IDs.
 func (api *NodeHTTPAPIClient) OutputIDsByBech32Address(ctx context.Context, bech32Addr string, includeSpentOutputs bool) (*AddressOutputsResponse, error) {
-	query := fmt.Sprintf(NodeAPIRouteAddressBech32Outputs, bech32Addr)
-	if includeSpentOutputs {
-		query += "?include-spent=true"
-	}
-
-	res := &AddressOutputsResponse{}
-	_, err := api.Do(ctx, http.MethodGet, query, nil, res)
-	if err != nil {
+	if _, err := api.parseBech32Address(bech32Addr); err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	return api.outputIDsByAddressRoute(ctx, fmt.Sprintf(NodeAPIRouteAddressBech32Outputs, bech32Addr), includeSpentOutputs, "")
 }
 
 // OutputsByBech32Address gets the outputs residing on the given Bech32 address.
@@ -676,9 +1152,48 @@ func (api *NodeHTTPAPIClient) OutputsByBech32Address(ctx context.Context, bech32
 // OutputIDsByEd25519Address gets output IDs of outputs residing on the given Ed25519Address.
 // Per default only unspent output IDs are returned. Set includeSpentOutputs to true to also return spent output IDs.
 func (api *NodeHTTPAPIClient) OutputIDsByEd25519Address(ctx context.Context, addr *Ed25519Address, includeSpentOutputs bool) (*AddressOutputsResponse, error) {
-	query := fmt.Sprintf(NodeAPIRouteAddressEd25519Outputs, addr.String())
+	return api.outputIDsByAddressRoute(ctx, fmt.Sprintf(NodeAPIRouteAddressEd25519Outputs, addr.String()), includeSpentOutputs, "")
+}
+
+// AllOutputIDsByEd25519Address gets the output IDs of all outputs residing on the given Ed25519Address
+// by following the cursor of paginated responses until all pages have been queried.
+// Per default only unspent output IDs are returned. Set includeSpentOutputs to true to also return spent output IDs.
+func (api *NodeHTTPAPIClient) AllOutputIDsByEd25519Address(ctx context.Context, addr *Ed25519Address, includeSpentOutputs bool) ([]OutputIDHex, error) {
+	route := fmt.Sprintf(NodeAPIRouteAddressEd25519Outputs, addr.String())
+
+	var outputIDs []OutputIDHex
+	var cursor string
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		res, err := api.outputIDsByAddressRoute(ctx, route, includeSpentOutputs, cursor)
+		if err != nil {
+			return nil, err
+		}
+		outputIDs = append(outputIDs, res.OutputIDs...)
+
+		if res.Cursor == "" || res.Cursor == cursor {
+			return outputIDs, nil
+		}
+		cursor = res.Cursor
+	}
+}
+
+// queries the output IDs of the given address outputs route.
+func (api *NodeHTTPAPIClient) outputIDsByAddressRoute(ctx context.Context, route string, includeSpentOutputs bool, cursor string) (*AddressOutputsResponse, error) {
+	params := url.Values{}
 	if includeSpentOutputs {
-		query += "?include-spent=true"
+		params.Set("include-spent", "true")
+	}
+	if cursor != "" {
+		params.Set("cursor", cursor)
+	}
+
+	query := route
+	if len(params) > 0 {
+		query += "?" + params.Encode()
 	}
 
 	res := &AddressOutputsResponse{}
@@ -797,9 +1312,44 @@ func (api *NodeHTTPAPIClient) MilestoneByIndex(ctx context.Context, index uint32
 		return nil, err
 	}
 
+	if api.opts.responseVerification && res.Index != index {
+		return nil, fmt.Errorf("%w: requested %d, got %d", ErrMilestoneIndexMismatch, index, res.Index)
+	}
+
 	return res, nil
 }
 
+// VerifyMilestone fetches the milestone with the given index and the message containing it from the node
+// and verifies the milestone's signatures against the given public keys applicable for that index.
+// It returns the error of Milestone.VerifySignatures if the verification fails.
+func (api *NodeHTTPAPIClient) VerifyMilestone(ctx context.Context, index uint32, minSigThreshold int, applicablePubKeys MilestonePublicKeySet) error {
+	res, err := api.MilestoneByIndex(ctx, index)
+	if err != nil {
+		return err
+	}
+
+	msgID, err := MessageIDFromHexString(res.MessageID)
+	if err != nil {
+		return fmt.Errorf("unable to decode message ID of milestone %d: %w", index, err)
+	}
+
+	msg, err := api.MessageByMessageID(ctx, msgID)
+	if err != nil {
+		return err
+	}
+
+	ms, ok := msg.Payload.(*Milestone)
+	if !ok {
+		return fmt.Errorf("%w: message %s holds %T instead of milestone %d", ErrUnsupportedPayloadType, res.MessageID, msg.Payload, index)
+	}
+
+	if ms.Index != index {
+		return fmt.Errorf("%w: requested %d, got %d", ErrMilestoneIndexMismatch, index, ms.Index)
+	}
+
+	return ms.VerifySignatures(minSigThreshold, applicablePubKeys)
+}
+
 // MilestoneUTXOChangesResponse defines the response of a GET milestone UTXO changes REST API call.
 type MilestoneUTXOChangesResponse struct {
 	// The index of the milestone.
@@ -954,3 +1504,124 @@ func (api *NodeHTTPAPIClient) AddPeer(ctx context.Context, multiAddress string,
 
 	return res, nil
 }
+
+// requestFlight is an in-flight or completed request whose response is shared by all its callers.
+type requestFlight struct {
+	done chan struct{}
+	res  *http.Response
+	body []byte
+	err  error
+}
+
+// requestFlightGroup coalesces concurrent requests with the same key into one round trip.
+type requestFlightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*requestFlight
+}
+
+// do executes roundTrip if there is no in-flight request for the given key, otherwise it waits for the
+// in-flight request to complete. Every caller gets its own copy of the response with a readable body.
+// If the in-flight request was aborted by the context of the caller which issued it, waiting callers
+// whose own context is still alive retry with their own round trip.
+func (g *requestFlightGroup) do(ctx context.Context, key string, roundTrip func() (*http.Response, error)) (*http.Response, error) {
+	for {
+		g.mu.Lock()
+		flight, inFlight := g.calls[key]
+		if !inFlight {
+			flight = &requestFlight{done: make(chan struct{})}
+			g.calls[key] = flight
+		}
+		g.mu.Unlock()
+
+		if !inFlight {
+			flight.res, flight.err = roundTrip()
+			if flight.err == nil {
+				flight.body, flight.err = readBody(flight.res)
+				_ = flight.res.Body.Close()
+			}
+
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+			close(flight.done)
+		} else {
+			select {
+			case <-flight.done:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+
+			if isContextError(flight.err) && ctx.Err() == nil {
+				continue
+			}
+		}
+
+		if flight.err != nil {
+			return nil, flight.err
+		}
+
+		res := *flight.res
+		res.Body = ioutil.NopCloser(bytes.NewReader(flight.body))
+		return &res, nil
+	}
+}
+
+// isContextError tells whether the given error stems from a cancelled context or an exceeded deadline.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// requestRateLimiter is a token bucket which refills at rate tokens per second up to burst tokens.
+type requestRateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newRequestRateLimiter(rate float64, burst int) *requestRateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &requestRateLimiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// wait blocks until a token is available or the given context is done.
+// It returns immediately if the context's deadline would pass before a token becomes available.
+func (l *requestRateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	// reserve a token, a negative amount of tokens denotes the callers queued up before the next token
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		l.release()
+		return fmt.Errorf("rate limit wait of %v exceeds context deadline: %w", delay, context.DeadlineExceeded)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.release()
+		return ctx.Err()
+	}
+}
+
+// release gives back a reserved but unused token.
+func (l *requestRateLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
@@ -2,18 +2,26 @@ package iotago_test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"gopkg.in/h2non/gock.v1"
 
+	"github.com/iotaledger/iota.go/v2/ed25519"
 	"github.com/iotaledger/iota.go/v2/tpkg"
 
 	iotago "github.com/iotaledger/iota.go/v2"
@@ -72,6 +80,245 @@ func TestNodeAPI_Info(t *testing.T) {
 	require.EqualValues(t, originInfo, info)
 }
 
+func TestNodeAPI_IsNodeSynced(t *testing.T) {
+	defer gock.Off()
+
+	tests := []struct {
+		name      string
+		latest    uint32
+		confirmed uint32
+		opts      []iotago.NodeHTTPAPIClientOption
+		synced    bool
+	}{
+		{name: "synced", latest: 1337, confirmed: 1337, synced: true},
+		{name: "within default tolerance", latest: 1337, confirmed: 1337 - iotago.DefaultNodeSyncTolerance, synced: true},
+		{name: "beyond default tolerance", latest: 1337, confirmed: 1337 - iotago.DefaultNodeSyncTolerance - 1, synced: false},
+		{name: "within custom tolerance", latest: 1337, confirmed: 1327, opts: []iotago.NodeHTTPAPIClientOption{iotago.WithNodeHTTPAPIClientSyncTolerance(10)}, synced: true},
+		{name: "zero tolerance", latest: 1337, confirmed: 1336, opts: []iotago.NodeHTTPAPIClientOption{iotago.WithNodeHTTPAPIClientSyncTolerance(0)}, synced: false},
+		{name: "huge tolerance", latest: math.MaxUint32, confirmed: 1337, opts: []iotago.NodeHTTPAPIClientOption{iotago.WithNodeHTTPAPIClientSyncTolerance(math.MaxUint32 - 1)}, synced: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gock.New(nodeAPIUrl).
+				Get(iotago.NodeAPIRouteInfo).
+				Reply(200).
+				JSON(&iotago.HTTPOkResponseEnvelope{Data: &iotago.NodeInfoResponse{
+					LatestMilestoneIndex:    tt.latest,
+					ConfirmedMilestoneIndex: tt.confirmed,
+				}})
+
+			nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl, tt.opts...)
+			synced, latest, confirmed, err := nodeAPI.IsNodeSynced(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.synced, synced)
+			require.Equal(t, tt.latest, latest)
+			require.Equal(t, tt.confirmed, confirmed)
+		})
+	}
+}
+
+func TestNodeAPI_SingleFlight(t *testing.T) {
+	originInfo := &iotago.NodeInfoResponse{Name: "HORNET", LatestMilestoneIndex: 1337}
+
+	var requests int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&iotago.HTTPOkResponseEnvelope{Data: originInfo})
+	}))
+	defer srv.Close()
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(srv.URL,
+		iotago.WithNodeHTTPAPIClientHTTPClient(srv.Client()),
+		iotago.WithNodeHTTPAPIClientSingleFlight(),
+	)
+
+	const callers = 5
+	var wg sync.WaitGroup
+	infos := make([]*iotago.NodeInfoResponse, callers)
+	errs := make([]error, callers)
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			infos[i], errs[i] = nodeAPI.Info(context.Background())
+		}(i)
+	}
+
+	// let the remaining callers join the in-flight request before the node answers
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&requests) == 1 }, time.Second, time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	require.EqualValues(t, 1, atomic.LoadInt32(&requests))
+	for i := 0; i < callers; i++ {
+		require.NoError(t, errs[i])
+		require.EqualValues(t, originInfo, infos[i])
+	}
+
+	// once completed, a new request issues a new round trip
+	_, err := nodeAPI.Info(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 2, atomic.LoadInt32(&requests))
+}
+
+func TestNodeAPI_SingleFlightFirstCallerCancels(t *testing.T) {
+	originInfo := &iotago.NodeInfoResponse{Name: "HORNET", LatestMilestoneIndex: 1337}
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			// the first request never gets answered before its caller gives up
+			<-r.Context().Done()
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(&iotago.HTTPOkResponseEnvelope{Data: originInfo})
+	}))
+	defer srv.Close()
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(srv.URL,
+		iotago.WithNodeHTTPAPIClientHTTPClient(srv.Client()),
+		iotago.WithNodeHTTPAPIClientSingleFlight(),
+	)
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := nodeAPI.Info(firstCtx)
+		firstErr <- err
+	}()
+	require.Eventually(t, func() bool { return atomic.LoadInt32(&requests) == 1 }, time.Second, time.Millisecond)
+
+	var info *iotago.NodeInfoResponse
+	secondErr := make(chan error, 1)
+	go func() {
+		var err error
+		info, err = nodeAPI.Info(context.Background())
+		secondErr <- err
+	}()
+
+	// let the second caller join the in-flight request before the first caller cancels
+	time.Sleep(50 * time.Millisecond)
+	cancelFirst()
+
+	require.True(t, errors.Is(<-firstErr, context.Canceled))
+	require.NoError(t, <-secondErr)
+	require.EqualValues(t, originInfo, info)
+	require.EqualValues(t, 2, atomic.LoadInt32(&requests))
+}
+
+func TestNodeAPI_RateLimit(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	const rps = 20
+	nodeAPI := iotago.NewNodeHTTPAPIClient(srv.URL,
+		iotago.WithNodeHTTPAPIClientHTTPClient(srv.Client()),
+		iotago.WithNodeHTTPAPIClientRateLimit(rps, 1),
+	)
+
+	// the first request uses the burst, the following ones have to wait for their turn
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		healthy, err := nodeAPI.Health(context.Background())
+		require.NoError(t, err)
+		require.True(t, healthy)
+	}
+	require.GreaterOrEqual(t, int64(time.Since(start)), int64(2*time.Second/rps*9/10))
+
+	// a wait exceeding the context's deadline aborts immediately
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	_, err := nodeAPI.Health(ctx)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestNodeAPI_GzipResponse(t *testing.T) {
+	originInfo := &iotago.NodeInfoResponse{Name: "HORNET", LatestMilestoneIndex: 1337}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		gzipWriter := gzip.NewWriter(w)
+		defer gzipWriter.Close()
+		_ = json.NewEncoder(gzipWriter).Encode(&iotago.HTTPOkResponseEnvelope{Data: originInfo})
+	}))
+	defer srv.Close()
+
+	for _, opts := range [][]iotago.NodeHTTPAPIClientOption{
+		{iotago.WithNodeHTTPAPIClientHTTPClient(srv.Client())},
+		{iotago.WithNodeHTTPAPIClientHTTPClient(srv.Client()), iotago.WithNodeHTTPAPIClientSingleFlight()},
+	} {
+		nodeAPI := iotago.NewNodeHTTPAPIClient(srv.URL, opts...)
+		info, err := nodeAPI.Info(context.Background())
+		require.NoError(t, err)
+		require.EqualValues(t, originInfo, info)
+	}
+}
+
+func TestNodeAPI_TooManyRequests(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(nodeAPIUrl).
+		Get(iotago.NodeAPIRouteInfo).
+		Reply(http.StatusTooManyRequests).
+		JSON(&iotago.HTTPErrorResponseEnvelope{})
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl)
+	_, err := nodeAPI.Info(context.Background())
+	require.True(t, errors.Is(err, iotago.ErrHTTPTooManyRequests))
+}
+
+type observedRequest struct {
+	method string
+	route  string
+	err    error
+}
+
+type recordingObserver struct {
+	requests []observedRequest
+}
+
+func (o *recordingObserver) ObserveRequest(method string, route string, duration time.Duration, err error) {
+	o.requests = append(o.requests, observedRequest{method: method, route: route, err: err})
+}
+
+func TestNodeAPI_Observer(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(nodeAPIUrl).
+		Get(iotago.NodeAPIRouteInfo).
+		Reply(200).
+		JSON(&iotago.HTTPOkResponseEnvelope{Data: &iotago.NodeInfoResponse{Name: "HORNET"}})
+
+	gock.New(nodeAPIUrl).
+		Get(iotago.NodeAPIRouteInfo).
+		Reply(http.StatusTooManyRequests).
+		JSON(&iotago.HTTPErrorResponseEnvelope{})
+
+	observer := &recordingObserver{}
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl, iotago.WithNodeHTTPAPIClientObserver(observer))
+
+	_, err := nodeAPI.Info(context.Background())
+	require.NoError(t, err)
+	_, err = nodeAPI.Info(context.Background())
+	require.Error(t, err)
+
+	require.Len(t, observer.requests, 2)
+	require.Equal(t, observedRequest{method: http.MethodGet, route: iotago.NodeAPIRouteInfo}, observer.requests[0])
+	require.Equal(t, http.MethodGet, observer.requests[1].method)
+	require.Equal(t, iotago.NodeAPIRouteInfo, observer.requests[1].route)
+	require.True(t, errors.Is(observer.requests[1].err, iotago.ErrHTTPTooManyRequests))
+}
+
 func TestNodeAPI_Tips(t *testing.T) {
 	defer gock.Off()
 
@@ -134,6 +381,46 @@ func TestNodeAPI_SubmitMessage(t *testing.T) {
 	require.EqualValues(t, completeMsg, resp)
 }
 
+func TestNodeAPI_SubmitMessageAsJSON(t *testing.T) {
+	defer gock.Off()
+
+	msgHash := tpkg.Rand32ByteArray()
+	msgHashStr := hex.EncodeToString(msgHash[:])
+
+	incompleteMsg := &iotago.Message{
+		Parents: tpkg.SortedRand32BytArray(1),
+	}
+
+	completeMsg := &iotago.Message{
+		Parents: tpkg.SortedRand32BytArray(1 + rand.Intn(7)),
+		Payload: nil,
+		Nonce:   3495721389537486,
+	}
+
+	serializedCompleteMsg, err := completeMsg.Serialize(iotago.DeSeriModeNoValidation)
+	require.NoError(t, err)
+
+	gock.New(nodeAPIUrl).
+		Post(iotago.NodeAPIRouteMessages).
+		MatchType("json").
+		JSON(incompleteMsg).
+		Reply(200).
+		AddHeader("Location", msgHashStr)
+
+	gock.New(nodeAPIUrl).
+		Get(fmt.Sprintf(iotago.NodeAPIRouteMessageBytes, msgHashStr)).
+		Reply(200).
+		Body(bytes.NewReader(serializedCompleteMsg))
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl)
+	resp, err := nodeAPI.SubmitMessageAs(context.Background(), incompleteMsg, iotago.MessageEncodingJSON)
+	require.NoError(t, err)
+	require.EqualValues(t, completeMsg, resp)
+
+	_, err = nodeAPI.SubmitMessageAs(context.Background(), incompleteMsg, iotago.MessageEncoding(42))
+	require.True(t, errors.Is(err, iotago.ErrUnknownMessageEncoding))
+}
+
 func TestNodeAPI_MessageIDsByIndex(t *testing.T) {
 	defer gock.Off()
 	index := "बेकार पाठ"
@@ -165,6 +452,51 @@ func TestNodeAPI_MessageIDsByIndex(t *testing.T) {
 	require.EqualValues(t, msgIDsByIndex, resMsgIDsByIndex)
 }
 
+func TestNodeAPI_MessageIDsByIndexes(t *testing.T) {
+	const missingIndexHex = "6d697373696e67"
+
+	var inFlight, maxInFlight int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		indexHex := r.URL.Query().Get("index")
+		if indexHex == missingIndexHex {
+			w.WriteHeader(http.StatusNotFound)
+			_ = json.NewEncoder(w).Encode(&iotago.HTTPErrorResponseEnvelope{})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(&iotago.HTTPOkResponseEnvelope{Data: &iotago.MessageIDsByIndexResponse{Index: indexHex}})
+	}))
+	defer srv.Close()
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(srv.URL, iotago.WithNodeHTTPAPIClientHTTPClient(srv.Client()))
+
+	indexes := [][]byte{[]byte("a"), []byte("b"), []byte("missing"), []byte("c"), []byte("d")}
+	res, err := nodeAPI.MessageIDsByIndexes(context.Background(), indexes, 2)
+	require.Error(t, err)
+	require.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+
+	var indexesErr iotago.MessageIDsByIndexesError
+	require.True(t, errors.As(err, &indexesErr))
+	require.Len(t, indexesErr, 1)
+	require.True(t, errors.Is(indexesErr[missingIndexHex], iotago.ErrHTTPNotFound))
+
+	require.Len(t, res, 4)
+	for _, index := range []string{"a", "b", "c", "d"} {
+		indexHex := hex.EncodeToString([]byte(index))
+		require.Equal(t, indexHex, res[indexHex].Index)
+	}
+}
+
 func TestNodeAPI_MessageMetadataByMessageID(t *testing.T) {
 	defer gock.Off()
 
@@ -199,6 +531,32 @@ func TestNodeAPI_MessageMetadataByMessageID(t *testing.T) {
 	meta, err := nodeAPI.MessageMetadataByMessageID(context.Background(), identifier)
 	require.NoError(t, err)
 	require.EqualValues(t, originRes, meta)
+	require.Equal(t, iotago.LedgerInclusionStateUnknown, meta.InclusionState())
+	require.False(t, meta.Referenced())
+	require.False(t, meta.PromotionNeeded())
+	require.False(t, meta.ReattachmentNeeded())
+}
+
+func TestMessageMetadataResponse_InclusionState(t *testing.T) {
+	metadataJSON := `{"messageId":"","parentMessageIds":[],"isSolid":true,"referencedByMilestoneIndex":10,"ledgerInclusionState":"conflicting","shouldPromote":true,"shouldReattach":false,"conflictReason":1}`
+
+	meta := &iotago.MessageMetadataResponse{}
+	require.NoError(t, json.Unmarshal([]byte(metadataJSON), meta))
+	require.Equal(t, iotago.LedgerInclusionStateConflicting, meta.InclusionState())
+	require.True(t, meta.Referenced())
+	require.True(t, meta.PromotionNeeded())
+	require.False(t, meta.ReattachmentNeeded())
+
+	state, err := iotago.ParseLedgerInclusionState("included")
+	require.NoError(t, err)
+	require.Equal(t, iotago.LedgerInclusionStateIncluded, state)
+	state, err = iotago.ParseLedgerInclusionState("bogus")
+	require.True(t, errors.Is(err, iotago.ErrUnknownLedgerInclusionState))
+	require.Equal(t, iotago.LedgerInclusionStateUnknown, state)
+
+	bogus := "bogus"
+	meta.LedgerInclusionState = &bogus
+	require.Equal(t, iotago.LedgerInclusionStateUnknown, meta.InclusionState())
 }
 
 func TestNodeAPI_MessageByMessageID(t *testing.T) {
@@ -227,6 +585,35 @@ func TestNodeAPI_MessageByMessageID(t *testing.T) {
 	require.EqualValues(t, originMsg, responseMsg)
 }
 
+func TestNodeAPI_MessageByMessageIDResponseVerification(t *testing.T) {
+	defer gock.Off()
+
+	originMsg := &iotago.Message{
+		Parents: tpkg.SortedRand32BytArray(2),
+		Nonce:   16345984576234,
+	}
+	data, err := originMsg.Serialize(iotago.DeSeriModePerformValidation)
+	require.NoError(t, err)
+	originMsgID, err := originMsg.ID()
+	require.NoError(t, err)
+	otherMsgID := tpkg.Rand32ByteArray()
+
+	for _, msgID := range []iotago.MessageID{*originMsgID, otherMsgID} {
+		gock.New(nodeAPIUrl).
+			Get(fmt.Sprintf(iotago.NodeAPIRouteMessageBytes, hex.EncodeToString(msgID[:]))).
+			Reply(200).
+			Body(bytes.NewReader(data))
+	}
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl, iotago.WithNodeHTTPAPIClientResponseVerification(true))
+	responseMsg, err := nodeAPI.MessageByMessageID(context.Background(), *originMsgID)
+	require.NoError(t, err)
+	require.EqualValues(t, originMsg, responseMsg)
+
+	_, err = nodeAPI.MessageByMessageID(context.Background(), otherMsgID)
+	require.True(t, errors.Is(err, iotago.ErrMessageIDMismatch))
+}
+
 func TestNodeAPI_ChildrenByMessageID(t *testing.T) {
 	defer gock.Off()
 
@@ -295,6 +682,49 @@ func TestNodeAPI_OutputByID(t *testing.T) {
 	require.EqualValues(t, txID, *resTxID)
 }
 
+func TestNodeAPI_OutputsForInputs(t *testing.T) {
+	defer gock.Off()
+
+	var inputs []*iotago.UTXOInput
+	var originOutputs iotago.Outputs
+	for i := 0; i < 3; i++ {
+		originOutput, _ := tpkg.RandSigLockedSingleOutput(iotago.AddressEd25519)
+		outputJson, err := originOutput.MarshalJSON()
+		require.NoError(t, err)
+		rawMsgOutputJson := json.RawMessage(outputJson)
+
+		input := &iotago.UTXOInput{TransactionID: tpkg.Rand32ByteArray(), TransactionOutputIndex: uint16(i)}
+		inputID := input.ID()
+
+		gock.New(nodeAPIUrl).
+			Get(fmt.Sprintf(iotago.NodeAPIRouteOutput, inputID.ToHex())).
+			Reply(200).
+			JSON(&iotago.HTTPOkResponseEnvelope{Data: &iotago.NodeOutputResponse{
+				TransactionID: hex.EncodeToString(input.TransactionID[:]),
+				OutputIndex:   input.TransactionOutputIndex,
+				RawOutput:     &rawMsgOutputJson,
+			}})
+
+		inputs = append(inputs, input)
+		originOutputs = append(originOutputs, originOutput)
+	}
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl)
+
+	output, deposit, err := nodeAPI.OutputForInput(context.Background(), inputs[0])
+	require.NoError(t, err)
+	require.EqualValues(t, originOutputs[0], output)
+	require.EqualValues(t, originOutputs[0].(*iotago.SigLockedSingleOutput).Amount, deposit)
+
+	outputs, err := nodeAPI.OutputsForInputs(context.Background(), inputs[1:])
+	require.NoError(t, err)
+	require.EqualValues(t, originOutputs[1:], outputs)
+
+	// the output of the first input was already consumed from the mocks
+	_, err = nodeAPI.OutputsForInputs(context.Background(), inputs)
+	require.Error(t, err)
+}
+
 func TestNodeAPI_BalanceByEd25519Address(t *testing.T) {
 	defer gock.Off()
 
@@ -318,6 +748,44 @@ func TestNodeAPI_BalanceByEd25519Address(t *testing.T) {
 	resp, err := nodeAPI.BalanceByEd25519Address(context.Background(), ed25519Addr)
 	require.NoError(t, err)
 	require.EqualValues(t, originRes, resp)
+
+	// unknown addresses have a zero balance
+	gock.New(nodeAPIUrl).
+		Get(fmt.Sprintf(iotago.NodeAPIRouteAddressEd25519Balance, ed25519AddrHex)).
+		Reply(404).
+		JSON(&iotago.HTTPErrorResponseEnvelope{})
+
+	resp, err = nodeAPI.BalanceByEd25519Address(context.Background(), ed25519Addr)
+	require.NoError(t, err)
+	require.EqualValues(t, &iotago.AddressBalanceResponse{AddressType: iotago.AddressEd25519, Address: ed25519AddrHex}, resp)
+}
+
+func TestNodeAPI_BalanceByBech32Address(t *testing.T) {
+	defer gock.Off()
+
+	ed25519Addr, _ := tpkg.RandEd25519Address()
+	bech32Addr := ed25519Addr.Bech32(iotago.PrefixTestnet)
+
+	originRes := &iotago.AddressBalanceResponse{
+		AddressType: iotago.AddressEd25519,
+		Address:     ed25519Addr.String(),
+		Balance:     13371337,
+		DustAllowed: true,
+		LedgerIndex: 1337,
+	}
+
+	gock.New(nodeAPIUrl).
+		Get(fmt.Sprintf(iotago.NodeAPIRouteAddressBech32Balance, bech32Addr)).
+		Reply(200).
+		JSON(&iotago.HTTPOkResponseEnvelope{Data: originRes})
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl, iotago.WithNodeHTTPAPIClientNetworkPrefix(iotago.PrefixTestnet))
+	resp, err := nodeAPI.BalanceByBech32Address(context.Background(), bech32Addr)
+	require.NoError(t, err)
+	require.EqualValues(t, originRes, resp)
+
+	_, err = nodeAPI.BalanceByBech32Address(context.Background(), ed25519Addr.Bech32(iotago.PrefixMainnet))
+	require.True(t, errors.Is(err, iotago.ErrBech32AddressNetworkMismatch))
 }
 
 func TestNodeAPI_OutputIDsByAddress(t *testing.T) {
@@ -375,6 +843,53 @@ func TestNodeAPI_OutputIDsByAddress(t *testing.T) {
 	require.EqualValues(t, originResWithUnspent, resp)
 }
 
+func TestNodeAPI_AllOutputIDsByEd25519Address(t *testing.T) {
+	defer gock.Off()
+
+	ed25519Addr, _ := tpkg.RandEd25519Address()
+	ed25519AddrHex := ed25519Addr.String()
+
+	output1 := tpkg.Rand32ByteArray()
+	output2 := tpkg.Rand32ByteArray()
+	firstPage := &iotago.AddressOutputsResponse{
+		AddressType: 1,
+		Address:     ed25519AddrHex,
+		MaxResults:  1,
+		Count:       1,
+		OutputIDs:   []iotago.OutputIDHex{iotago.OutputIDHex(hex.EncodeToString(output1[:]))},
+		Cursor:      "next",
+	}
+	secondPage := &iotago.AddressOutputsResponse{
+		AddressType: 1,
+		Address:     ed25519AddrHex,
+		MaxResults:  1,
+		Count:       1,
+		OutputIDs:   []iotago.OutputIDHex{iotago.OutputIDHex(hex.EncodeToString(output2[:]))},
+	}
+
+	route := fmt.Sprintf(iotago.NodeAPIRouteAddressEd25519Outputs, ed25519AddrHex)
+	gock.New(nodeAPIUrl).
+		Get(route).
+		MatchParam("cursor", "next").
+		Reply(200).
+		JSON(&iotago.HTTPOkResponseEnvelope{Data: secondPage})
+
+	gock.New(nodeAPIUrl).
+		Get(route).
+		Reply(200).
+		JSON(&iotago.HTTPOkResponseEnvelope{Data: firstPage})
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl)
+	outputIDs, err := nodeAPI.AllOutputIDsByEd25519Address(context.Background(), ed25519Addr, false)
+	require.NoError(t, err)
+	require.EqualValues(t, append(firstPage.OutputIDs, secondPage.OutputIDs...), outputIDs)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = nodeAPI.AllOutputIDsByEd25519Address(ctx, ed25519Addr, false)
+	require.True(t, errors.Is(err, context.Canceled))
+}
+
 func TestNodeHTTPAPIClient_Treasury(t *testing.T) {
 	defer gock.Off()
 
@@ -494,6 +1009,61 @@ func TestNodeAPI_MilestoneByIndex(t *testing.T) {
 	require.EqualValues(t, originRes, resp)
 }
 
+func TestNodeAPI_MilestoneByIndexResponseVerification(t *testing.T) {
+	defer gock.Off()
+
+	gock.New(nodeAPIUrl).
+		Get(fmt.Sprintf(iotago.NodeAPIRouteMilestone, "1337")).
+		Reply(200).
+		JSON(&iotago.HTTPOkResponseEnvelope{Data: &iotago.MilestoneResponse{Index: 1338}})
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl, iotago.WithNodeHTTPAPIClientResponseVerification(true))
+	_, err := nodeAPI.MilestoneByIndex(context.Background(), 1337)
+	require.True(t, errors.Is(err, iotago.ErrMilestoneIndexMismatch))
+}
+
+func TestNodeAPI_VerifyMilestone(t *testing.T) {
+	defer gock.Off()
+
+	prvKey := tpkg.RandEd25519PrivateKey()
+	var pubKey iotago.MilestonePublicKey
+	copy(pubKey[:], prvKey.Public().(ed25519.PublicKey))
+
+	parents := tpkg.SortedRand32BytArray(2)
+	ms := &iotago.Milestone{
+		Parents:              parents,
+		Index:                1337,
+		Timestamp:            uint64(time.Now().Unix()),
+		PublicKeys:           []iotago.MilestonePublicKey{pubKey},
+		InclusionMerkleProof: tpkg.Rand32ByteArray(),
+	}
+	require.NoError(t, ms.Sign(iotago.InMemoryEd25519MilestoneSigner(iotago.MilestonePublicKeyMapping{pubKey: prvKey})))
+
+	msg, err := ms.AsMessage(1, parents)
+	require.NoError(t, err)
+	msgData, err := msg.Serialize(iotago.DeSeriModePerformValidation)
+	require.NoError(t, err)
+	msgID := tpkg.Rand32ByteArray()
+
+	for i := 0; i < 2; i++ {
+		gock.New(nodeAPIUrl).
+			Get(fmt.Sprintf(iotago.NodeAPIRouteMilestone, "1337")).
+			Reply(200).
+			JSON(&iotago.HTTPOkResponseEnvelope{Data: &iotago.MilestoneResponse{Index: 1337, MessageID: iotago.MessageIDToHexString(msgID)}})
+
+		gock.New(nodeAPIUrl).
+			Get(fmt.Sprintf(iotago.NodeAPIRouteMessageBytes, iotago.MessageIDToHexString(msgID))).
+			Reply(200).
+			Body(bytes.NewReader(msgData))
+	}
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl)
+	require.NoError(t, nodeAPI.VerifyMilestone(context.Background(), 1337, 1, iotago.MilestonePublicKeySet{pubKey: {}}))
+
+	err = nodeAPI.VerifyMilestone(context.Background(), 1337, 1, iotago.MilestonePublicKeySet{tpkg.Rand32ByteArray(): {}})
+	require.True(t, errors.Is(err, iotago.ErrMilestoneNonApplicablePublicKey))
+}
+
 func TestNodeAPI_MilestoneUTXOChangesByIndex(t *testing.T) {
 	defer gock.Off()
 
@@ -25,6 +25,8 @@ const (
 var (
 	// ErrDepositAmountMustBeGreaterThanZero returned if the deposit amount of an output is less or equal zero.
 	ErrDepositAmountMustBeGreaterThanZero = errors.New("deposit amount must be greater than zero")
+	// ErrInvalidOutputIDHex gets returned if a hex output ID is not valid hex or does not have the length of a UTXOInputID.
+	ErrInvalidOutputIDHex = errors.New("invalid hex output ID")
 )
 
 // Outputs is a slice of Output.
@@ -61,6 +63,11 @@ func OutputSelector(outputType uint32) (Serializable, error) {
 // OutputIDHex is the hex representation of an output ID.
 type OutputIDHex string
 
+// ParseOutputID parses the given hex output ID into a UTXOInput.
+func ParseOutputID(outputIDHex string) (*UTXOInput, error) {
+	return OutputIDHex(outputIDHex).AsUTXOInput()
+}
+
 // MustSplitParts returns the transaction ID and output index parts of the hex output ID.
 // It panics if the hex output ID is invalid.
 func (oih OutputIDHex) MustSplitParts() (*TransactionID, uint16) {
@@ -75,7 +82,10 @@ func (oih OutputIDHex) MustSplitParts() (*TransactionID, uint16) {
 func (oih OutputIDHex) SplitParts() (*TransactionID, uint16, error) {
 	outputIDBytes, err := hex.DecodeString(string(oih))
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, fmt.Errorf("%w: %s", ErrInvalidOutputIDHex, err)
+	}
+	if len(outputIDBytes) != TransactionIDLength+UInt16ByteSize {
+		return nil, 0, fmt.Errorf("%w: length must be %d bytes but is %d", ErrInvalidOutputIDHex, TransactionIDLength+UInt16ByteSize, len(outputIDBytes))
 	}
 	var txID TransactionID
 	copy(txID[:], outputIDBytes[:TransactionIDLength])
@@ -160,17 +170,14 @@ func OutputsDepositAmountValidator() OutputsValidatorFunc {
 		if err != nil {
 			return fmt.Errorf("unable to get deposit of output: %w", err)
 		}
-		if deposit == 0 {
-			return fmt.Errorf("%w: output %d", ErrDepositAmountMustBeGreaterThanZero, index)
+		if err := ValidateOutputAmount(deposit); err != nil {
+			return fmt.Errorf("%w: output %d", err, index)
 		}
 		if _, isAllowanceOutput := dep.(*SigLockedDustAllowanceOutput); isAllowanceOutput {
 			if deposit < OutputSigLockedDustAllowanceOutputMinDeposit {
 				return fmt.Errorf("%w: output %d", ErrOutputDustAllowanceLessThanMinDeposit, index)
 			}
 		}
-		if deposit > TokenSupply {
-			return fmt.Errorf("%w: output %d", ErrOutputDepositsMoreThanTotalSupply, index)
-		}
 		if sum+deposit > TokenSupply {
 			return fmt.Errorf("%w: output %d", ErrOutputsSumExceedsTotalSupply, index)
 		}
@@ -181,6 +188,18 @@ func OutputsDepositAmountValidator() OutputsValidatorFunc {
 	}
 }
 
+// ValidateOutputAmount checks that the given amount is greater than zero and does not exceed the TokenSupply.
+// It returns ErrDepositAmountMustBeGreaterThanZero or ErrOutputDepositsMoreThanTotalSupply otherwise.
+func ValidateOutputAmount(amount uint64) error {
+	switch {
+	case amount == 0:
+		return ErrDepositAmountMustBeGreaterThanZero
+	case amount > TokenSupply:
+		return fmt.Errorf("%w: amount %d, total supply %d", ErrOutputDepositsMoreThanTotalSupply, amount, TokenSupply)
+	}
+	return nil
+}
+
 // supposed to be called with -1 as input in order to be used over multiple calls.
 var outputAmountValidator = OutputsDepositAmountValidator()
 
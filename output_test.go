@@ -170,3 +170,40 @@ func TestOutputsValidatorFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateOutputAmount(t *testing.T) {
+	assert.NoError(t, iotago.ValidateOutputAmount(1))
+	assert.NoError(t, iotago.ValidateOutputAmount(iotago.TokenSupply))
+	assert.True(t, errors.Is(iotago.ValidateOutputAmount(0), iotago.ErrDepositAmountMustBeGreaterThanZero))
+	assert.True(t, errors.Is(iotago.ValidateOutputAmount(iotago.TokenSupply+1), iotago.ErrOutputDepositsMoreThanTotalSupply))
+
+	defer func() { iotago.TokenSupply = iotago.MainnetTokenSupply }()
+	iotago.TokenSupply = 1_000_000
+	assert.NoError(t, iotago.ValidateOutputAmount(1_000_000))
+	assert.True(t, errors.Is(iotago.ValidateOutputAmount(1_000_001), iotago.ErrOutputDepositsMoreThanTotalSupply))
+
+	outputs := iotago.Serializables{
+		&iotago.SigLockedSingleOutput{Amount: 600_000},
+		&iotago.SigLockedSingleOutput{Amount: 600_000},
+	}
+	err := iotago.ValidateOutputs(outputs, iotago.OutputsDepositAmountValidator())
+	assert.True(t, errors.Is(err, iotago.ErrOutputsSumExceedsTotalSupply))
+}
+
+func TestParseOutputID(t *testing.T) {
+	utxoInput := &iotago.UTXOInput{TransactionID: tpkg.Rand32ByteArray(), TransactionOutputIndex: 258}
+	outputIDHex := utxoInput.OutputIDHex()
+
+	// the output index is encoded in little endian after the transaction ID
+	assert.Equal(t, "0201", string(outputIDHex[len(outputIDHex)-4:]))
+
+	parsed, err := iotago.ParseOutputID(string(outputIDHex))
+	assert.NoError(t, err)
+	assert.Equal(t, utxoInput, parsed)
+
+	_, err = iotago.ParseOutputID(string(outputIDHex[:len(outputIDHex)-2]))
+	assert.True(t, errors.Is(err, iotago.ErrInvalidOutputIDHex))
+
+	_, err = iotago.ParseOutputID("zz" + string(outputIDHex[2:]))
+	assert.True(t, errors.Is(err, iotago.ErrInvalidOutputIDHex))
+}
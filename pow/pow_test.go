@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"math"
 	"math/rand"
+	"runtime"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -51,6 +52,37 @@ func TestWorker_Mine(t *testing.T) {
 	assert.GreaterOrEqual(t, pow, targetScore)
 }
 
+func TestWorker_Hashrate(t *testing.T) {
+	w := New(workers)
+	assert.Zero(t, w.Hashrate())
+
+	_, err := w.Mine(context.Background(), []byte("Hello, World!"), targetScore)
+	require.NoError(t, err)
+	assert.Greater(t, w.Hashrate(), 0.)
+}
+
+func TestOptimalWorkerCount(t *testing.T) {
+	n := OptimalWorkerCount()
+	assert.GreaterOrEqual(t, n, 1)
+	assert.LessOrEqual(t, n, runtime.NumCPU())
+	assert.Equal(t, n, New(n).NumWorkers())
+	assert.Equal(t, 1, New().NumWorkers())
+}
+
+func TestEstimateDuration(t *testing.T) {
+	const dataLen = 100
+
+	// a target score requiring 10 trailing zeros
+	score := math.Pow(3, 10) / (dataLen + nonceBytes)
+	assert.Equal(t, time.Duration(math.Pow(3, 10))*time.Millisecond, EstimateDuration(dataLen, score, 1000))
+
+	// more data requires more trailing zeros for the same score
+	assert.Greater(t, int64(EstimateDuration(10*dataLen, score, 1000)), int64(EstimateDuration(dataLen, score, 1000)))
+
+	assert.Zero(t, EstimateDuration(dataLen, score, 0))
+	assert.Equal(t, time.Duration(math.MaxInt64), EstimateDuration(dataLen, 1e100, 1))
+}
+
 func TestWorker_Cancel(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -5,8 +5,10 @@ import (
 	"errors"
 	"math"
 	"math/bits"
+	"runtime"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	legacy "github.com/iotaledger/iota.go/consts"
 	"github.com/iotaledger/iota.go/curl/bct"
@@ -23,6 +25,8 @@ var (
 // The Worker performs the PoW.
 type Worker struct {
 	numWorkers int
+	// the float64 bits of the hashrate measured during the last call to Mine
+	hashrate uint64
 }
 
 // New creates a new PoW Worker.
@@ -37,8 +41,49 @@ func New(numWorkers ...int) *Worker {
 	return w
 }
 
+// OptimalWorkerCount returns the number of workers to use for the PoW when not specified otherwise.
+// It leaves one CPU core for the rest of the application, but always returns at least 1.
+func OptimalWorkerCount() int {
+	if n := runtime.NumCPU() - 1; n > 1 {
+		return n
+	}
+	return 1
+}
+
+// NumWorkers returns the number of go routines the Worker uses to perform the PoW.
+func (w *Worker) NumWorkers() int {
+	return w.numWorkers
+}
+
 const ln3 = 1.098612288668109691395245236922525704647490557822749451734694333 // https://oeis.org/A002391
 
+// Hashrate returns the number of hashes per second the Worker computed during its last call to Mine.
+// It returns 0 if the Worker has not mined yet.
+func (w *Worker) Hashrate() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&w.hashrate))
+}
+
+// EstimateDuration returns the expected time it takes to mine a nonce for data of length dataLen
+// (excluding the nonce) to reach a PoW score of at least targetScore, given a hashrate in hashes per second.
+// It returns 0 if hashrate is not positive.
+func EstimateDuration(dataLen int, targetScore float64, hashrate float64) time.Duration {
+	if hashrate <= 0 {
+		return 0
+	}
+	// on average 3^targetZeros hashes are needed to find a hash with at least targetZeros trailing zeros
+	expectedHashes := math.Pow(legacy.TrinaryRadix, float64(targetTrailingZeros(dataLen, targetScore)))
+	seconds := expectedHashes / hashrate
+	if seconds >= float64(math.MaxInt64)/float64(time.Second) {
+		return math.MaxInt64
+	}
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// targetTrailingZeros computes the minimum numbers of trailing zeros required to get a PoW score ≥ targetScore.
+func targetTrailingZeros(dataLen int, targetScore float64) uint {
+	return uint(math.Ceil(math.Log(float64(dataLen+nonceBytes)*targetScore) / ln3))
+}
+
 // Mine performs the PoW for data.
 // It returns a nonce that appended to data results in a PoW score of at least targetScore.
 // The computation can be canceled anytime using ctx.
@@ -66,8 +111,8 @@ func (w *Worker) Mine(ctx context.Context, data []byte, targetScore float64) (ui
 		}
 	}()
 
-	// compute the minimum numbers of trailing zeros required to get a PoW score ≥ targetScore
-	targetZeros := uint(math.Ceil(math.Log(float64(len(data)+nonceBytes)*targetScore) / ln3))
+	targetZeros := targetTrailingZeros(len(data), targetScore)
+	start := time.Now()
 
 	workerWidth := math.MaxUint64 / uint64(w.numWorkers)
 	for i := 0; i < w.numWorkers; i++ {
@@ -88,6 +133,10 @@ func (w *Worker) Mine(ctx context.Context, data []byte, targetScore float64) (ui
 	close(results)
 	close(closing)
 
+	if elapsed := time.Since(start).Seconds(); elapsed > 0 {
+		atomic.StoreUint64(&w.hashrate, math.Float64bits(float64(atomic.LoadUint64(&counter))/elapsed))
+	}
+
 	nonce, ok := <-results
 	if !ok {
 		return 0, ErrCancelled
@@ -0,0 +1,73 @@
+package iotago
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// RawPayload is a placeholder for a payload of a type unknown to this library.
+// It is produced when deserializing with DeSeriModeTolerateUnknownPayload and keeps
+// the payload's bytes as is, so that it re-serializes into the identical byte representation.
+type RawPayload struct {
+	// The type ID of the payload.
+	Type uint32
+	// The serialized payload without its type denotation.
+	Data []byte
+}
+
+func (r *RawPayload) Deserialize(data []byte, deSeriMode DeSerializationMode) (int, error) {
+	if len(data) < TypeDenotationByteSize {
+		return 0, fmt.Errorf("%w: unable to deserialize raw payload type", ErrDeserializationNotEnoughData)
+	}
+	r.Type = binary.LittleEndian.Uint32(data)
+	r.Data = make([]byte, len(data)-TypeDenotationByteSize)
+	copy(r.Data, data[TypeDenotationByteSize:])
+	return len(data), nil
+}
+
+func (r *RawPayload) Serialize(deSeriMode DeSerializationMode) ([]byte, error) {
+	return NewSerializer().
+		WriteNum(r.Type, func(err error) error {
+			return fmt.Errorf("unable to serialize raw payload type: %w", err)
+		}).
+		WriteBytes(r.Data, func(err error) error {
+			return fmt.Errorf("unable to serialize raw payload data: %w", err)
+		}).
+		Serialize()
+}
+
+func (r *RawPayload) MarshalJSON() ([]byte, error) {
+	jRawPayload := &jsonRawPayload{}
+	jRawPayload.Type = int(r.Type)
+	jRawPayload.Data = hex.EncodeToString(r.Data)
+	return json.Marshal(jRawPayload)
+}
+
+func (r *RawPayload) UnmarshalJSON(bytes []byte) error {
+	jRawPayload := &jsonRawPayload{}
+	if err := json.Unmarshal(bytes, jRawPayload); err != nil {
+		return err
+	}
+	seri, err := jRawPayload.ToSerializable()
+	if err != nil {
+		return err
+	}
+	*r = *seri.(*RawPayload)
+	return nil
+}
+
+// jsonRawPayload defines the json representation of a RawPayload.
+type jsonRawPayload struct {
+	Type int    `json:"type"`
+	Data string `json:"data"`
+}
+
+func (j *jsonRawPayload) ToSerializable() (Serializable, error) {
+	dataBytes, err := hex.DecodeString(j.Data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode data from JSON for raw payload: %w", err)
+	}
+	return &RawPayload{Type: uint32(j.Type), Data: dataBytes}, nil
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"sort"
 )
 
 const (
@@ -41,7 +40,8 @@ type Receipt struct {
 
 // SortFunds sorts the funds within the receipt after their serialized binary form in lexical order.
 func (r *Receipt) SortFunds() {
-	sort.Sort(SortedSerializables(r.Funds))
+	// entries which fail to serialize are reported by the serialization of the receipt itself
+	_ = sortSerializablesLexically(r.Funds)
 }
 
 // Sum returns the sum of all MigratedFundsEntry items within the Receipt.
@@ -243,8 +243,8 @@ var (
 //	- None of the MigratedFundsEntry objects deposits more than the max supply and deposits at least
 //	  MinMigratedFundsEntryDeposit tokens.
 //	- The sum of all migrated fund entries is not bigger than the total supply.
-//	- The previous unspent TreasuryOutput minus the sum of all migrated funds
-//    equals the amount of the new TreasuryOutput.
+//	- The embedded treasury transaction is valid as defined by ValidateTreasuryTransaction given
+//	  the previous unspent TreasuryOutput and the sum of all migrated funds.
 // This function panics if the receipt is nil, the receipt does not include any migrated fund entries or
 // the given treasury output is nil.
 func ValidateReceipt(receipt *Receipt, prevTreasuryOutput *TreasuryOutput) error {
@@ -271,23 +271,20 @@ func ValidateReceipt(receipt *Receipt, prevTreasuryOutput *TreasuryOutput) error
 		}
 		seenTailTxHashes[entry.TailTransactionHash] = fIndex
 
-		switch {
-		case entry.Deposit < MinMigratedFundsEntryDeposit:
-			return fmt.Errorf("%w: migrated fund entry at index %d deposits less than %d", ErrInvalidReceipt, fIndex, MinMigratedFundsEntryDeposit)
-		case entry.Deposit > TokenSupply:
-			return fmt.Errorf("%w: migrated fund entry at index %d deposits more than total supply", ErrInvalidReceipt, fIndex)
-		case entry.Deposit+migratedFundsSum > TokenSupply:
-			// this can't overflow because the previous case ensures that
+		if err := validateMigratedFundsEntryDeposit(entry.Deposit); err != nil {
+			return fmt.Errorf("%w: migrated fund entry at index %d: %v", ErrInvalidReceipt, fIndex, err)
+		}
+
+		// this can't overflow because the deposit does not exceed the total supply
+		if entry.Deposit+migratedFundsSum > TokenSupply {
 			return fmt.Errorf("%w: migrated fund entry at index %d overflows total supply", ErrInvalidReceipt, fIndex)
 		}
 
 		migratedFundsSum += entry.Deposit
 	}
 
-	prevTreasury := prevTreasuryOutput.Amount
-	newTreasury := treasuryTransaction.Output.(*TreasuryOutput).Amount
-	if prevTreasury-migratedFundsSum != newTreasury {
-		return fmt.Errorf("%w: new treasury amount mismatch, prev %d, delta %d (migrated funds), new %d", ErrInvalidReceipt, prevTreasury, migratedFundsSum, newTreasury)
+	if err := ValidateTreasuryTransaction(treasuryTransaction, prevTreasuryOutput.Amount, migratedFundsSum); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidReceipt, err)
 	}
 
 	return nil
@@ -18,6 +18,12 @@ type ReceiptBuilder struct {
 	r *Receipt
 }
 
+// Final sets whether the receipt is the final one for its migrated at index.
+func (rb *ReceiptBuilder) Final(final bool) *ReceiptBuilder {
+	rb.r.Final = final
+	return rb
+}
+
 // AddEntry adds the given MigratedFundsEntry to the receipt.
 func (rb *ReceiptBuilder) AddEntry(entry *MigratedFundsEntry) *ReceiptBuilder {
 	rb.r.Funds = append(rb.r.Funds, entry)
@@ -32,7 +38,18 @@ func (rb *ReceiptBuilder) AddTreasuryTransaction(tx *TreasuryTransaction) *Recei
 }
 
 // Build builds the Receipt.
+// The funds are sorted in lexical order and every MigratedFundsEntry must deposit at least
+// MinMigratedFundsEntryDeposit and not more than the TokenSupply.
 func (rb *ReceiptBuilder) Build() (*Receipt, error) {
+	for i, f := range rb.r.Funds {
+		entry, ok := f.(*MigratedFundsEntry)
+		if !ok {
+			return nil, fmt.Errorf("unable to build receipt: fund at index %d is not a migrated funds entry but %T", i, f)
+		}
+		if err := validateMigratedFundsEntryDeposit(entry.Deposit); err != nil {
+			return nil, fmt.Errorf("unable to build receipt: entry at index %d: %w", i, err)
+		}
+	}
 	if _, err := rb.r.Serialize(DeSeriModePerformValidation | DeSeriModePerformLexicalOrdering); err != nil {
 		return nil, fmt.Errorf("unable to build receipt: %w", err)
 	}
@@ -2,6 +2,7 @@ package iotago_test
 
 import (
 	"errors"
+	"math"
 	"github.com/iotaledger/iota.go/v2/tpkg"
 	"testing"
 
@@ -22,6 +23,12 @@ func TestReceipt_Deserialize(t *testing.T) {
 			receipt, receiptData := tpkg.RandReceipt()
 			return test{"ok", receiptData, receipt, nil}
 		}(),
+		func() test {
+			_, receiptData := tpkg.RandReceipt()
+			// the final flag follows the payload type and the migrated at index
+			receiptData[iotago.TypeDenotationByteSize+iotago.UInt32ByteSize] = 2
+			return test{"err - invalid final flag", receiptData, nil, iotago.ErrDeserializationInvalidBoolValue}
+		}(),
 	}
 
 	for _, tt := range tests {
@@ -97,6 +104,45 @@ func TestReceiptFuzzingCrashers(t *testing.T) {
 	}
 }
 
+func TestReceiptBuilder(t *testing.T) {
+	addr, _ := tpkg.RandEd25519Address()
+	entries := make([]*iotago.MigratedFundsEntry, 3)
+	for i := range entries {
+		entries[i] = &iotago.MigratedFundsEntry{
+			TailTransactionHash: tpkg.Rand49ByteArray(),
+			Address:             addr,
+			Deposit:             iotago.MinMigratedFundsEntryDeposit,
+		}
+	}
+	treasuryTx, _ := tpkg.RandTreasuryTransaction()
+
+	receipt, err := iotago.NewReceiptBuilder(100).
+		Final(true).
+		AddEntry(entries[0]).
+		AddEntry(entries[1]).
+		AddEntry(entries[2]).
+		AddTreasuryTransaction(treasuryTx).
+		Build()
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, receipt.MigratedAt)
+	assert.True(t, receipt.Final)
+	assert.Len(t, receipt.Funds, 3)
+
+	receiptData, err := receipt.Serialize(iotago.DeSeriModePerformValidation)
+	require.NoError(t, err)
+	desReceipt := &iotago.Receipt{}
+	_, err = desReceipt.Deserialize(receiptData, iotago.DeSeriModePerformValidation)
+	require.NoError(t, err)
+	assert.EqualValues(t, receipt, desReceipt)
+
+	_, err = iotago.NewReceiptBuilder(100).AddEntry(&iotago.MigratedFundsEntry{
+		TailTransactionHash: tpkg.Rand49ByteArray(),
+		Address:             addr,
+		Deposit:             iotago.MinMigratedFundsEntryDeposit - 1,
+	}).AddTreasuryTransaction(treasuryTx).Build()
+	assert.True(t, errors.Is(err, iotago.ErrMigratedFundsEntryDepositInvalid))
+}
+
 func TestValidateReceipts(t *testing.T) {
 	type test struct {
 		name      string
@@ -123,20 +169,30 @@ func TestValidateReceipts(t *testing.T) {
 		}(),
 		func() test {
 			addr, _ := tpkg.RandEd25519Address()
-			receipt, _ := iotago.NewReceiptBuilder(100).AddEntry(&iotago.MigratedFundsEntry{
-				TailTransactionHash: tpkg.Rand49ByteArray(),
-				Address:             addr,
-				Deposit:             1000,
-			}).AddTreasuryTransaction(sampleTreasuryTx).Build()
+			// the ReceiptBuilder refuses to build such a receipt
+			receipt := &iotago.Receipt{
+				MigratedAt: 100,
+				Funds: iotago.Serializables{&iotago.MigratedFundsEntry{
+					TailTransactionHash: tpkg.Rand49ByteArray(),
+					Address:             addr,
+					Deposit:             1000,
+				}},
+				Transaction: sampleTreasuryTx,
+			}
 			return test{"err - migrated less tha minimum", receipt, currentTreasury, iotago.ErrInvalidReceipt}
 		}(),
 		func() test {
 			addr, _ := tpkg.RandEd25519Address()
-			receipt, _ := iotago.NewReceiptBuilder(100).AddEntry(&iotago.MigratedFundsEntry{
-				TailTransactionHash: tpkg.Rand49ByteArray(),
-				Address:             addr,
-				Deposit:             iotago.TokenSupply + 1,
-			}).AddTreasuryTransaction(sampleTreasuryTx).Build()
+			// the ReceiptBuilder refuses to build such a receipt
+			receipt := &iotago.Receipt{
+				MigratedAt: 100,
+				Funds: iotago.Serializables{&iotago.MigratedFundsEntry{
+					TailTransactionHash: tpkg.Rand49ByteArray(),
+					Address:             addr,
+					Deposit:             iotago.TokenSupply + 1,
+				}},
+				Transaction: sampleTreasuryTx,
+			}
 			return test{"err - total supply overflow", receipt, currentTreasury, iotago.ErrInvalidReceipt}
 		}(),
 		func() test {
@@ -148,6 +204,20 @@ func TestValidateReceipts(t *testing.T) {
 			}).AddTreasuryTransaction(sampleTreasuryTx).Build()
 			return test{"err - invalid new treasury amount", receipt, currentTreasury, iotago.ErrInvalidReceipt}
 		}(),
+		func() test {
+			addr, _ := tpkg.RandEd25519Address()
+			// the previous treasury minus the migrated funds would wrap around to the new treasury amount
+			receipt := &iotago.Receipt{
+				MigratedAt: 100,
+				Funds: iotago.Serializables{&iotago.MigratedFundsEntry{
+					TailTransactionHash: tpkg.Rand49ByteArray(),
+					Address:             addr,
+					Deposit:             currentTreasury.Amount + 1,
+				}},
+				Transaction: &iotago.TreasuryTransaction{Input: treasuryInput, Output: &iotago.TreasuryOutput{Amount: math.MaxUint64}},
+			}
+			return test{"err - migrated funds exceed previous treasury", receipt, currentTreasury, iotago.ErrInvalidReceipt}
+		}(),
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
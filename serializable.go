@@ -39,6 +39,9 @@ const (
 	DeSeriModePerformValidation DeSerializationMode = 1 << 0
 	// DeSeriModePerformLexicalOrdering instructs de/deserialization to perform ordering of certain struct arrays by their lexical serialized form.
 	DeSeriModePerformLexicalOrdering DeSerializationMode = 1 << 1
+	// DeSeriModeTolerateUnknownPayload instructs deserialization to keep payloads of an unknown type as RawPayload
+	// instead of returning ErrUnknownPayloadType.
+	DeSeriModeTolerateUnknownPayload DeSerializationMode = 1 << 2
 )
 
 // HasMode checks whether the de/serialization mode includes the given mode.
@@ -217,6 +220,9 @@ func RemoveDupsAndSortByLexicalOrderArrayOf32Bytes(slice SliceOfArraysOf32Bytes)
 }
 
 // SortedSerializables are Serializables sorted by their serialized form.
+//
+// Deprecated: SortedSerializables serializes the elements on every comparison,
+// use SortInputsLexically or SortOutputsLexically instead.
 type SortedSerializables Serializables
 
 func (ss SortedSerializables) Len() int {
@@ -232,3 +238,48 @@ func (ss SortedSerializables) Less(i, j int) bool {
 func (ss SortedSerializables) Swap(i, j int) {
 	ss[i], ss[j] = ss[j], ss[i]
 }
+
+// SortInputsLexically sorts the given inputs in place by their serialized lexical representation.
+// The serialized form of each input is computed only once.
+func SortInputsLexically(inputs Serializables) error {
+	return sortSerializablesLexically(inputs)
+}
+
+// SortOutputsLexically sorts the given outputs in place by their serialized lexical representation.
+// The serialized form of each output is computed only once.
+func SortOutputsLexically(outputs Serializables) error {
+	return sortSerializablesLexically(outputs)
+}
+
+// sorts the given Serializables in place by their serialized form.
+func sortSerializablesLexically(seris Serializables) error {
+	serialized := make(LexicalOrderedByteSlices, len(seris))
+	for i, seri := range seris {
+		data, err := seri.Serialize(DeSeriModeNoValidation)
+		if err != nil {
+			return fmt.Errorf("unable to serialize element at index %d for lexical sorting: %w", i, err)
+		}
+		serialized[i] = data
+	}
+	sort.Sort(&lexicalSortedSerializables{seris: seris, serialized: serialized})
+	return nil
+}
+
+// lexicalSortedSerializables sorts Serializables by their pre-computed serialized form.
+type lexicalSortedSerializables struct {
+	seris      Serializables
+	serialized LexicalOrderedByteSlices
+}
+
+func (l *lexicalSortedSerializables) Len() int {
+	return len(l.seris)
+}
+
+func (l *lexicalSortedSerializables) Less(i, j int) bool {
+	return l.serialized.Less(i, j)
+}
+
+func (l *lexicalSortedSerializables) Swap(i, j int) {
+	l.seris[i], l.seris[j] = l.seris[j], l.seris[i]
+	l.serialized.Swap(i, j)
+}
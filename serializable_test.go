@@ -1,6 +1,7 @@
 package iotago_test
 
 import (
+	"bytes"
 	"errors"
 	"github.com/iotaledger/iota.go/v2/tpkg"
 	"sort"
@@ -215,6 +216,40 @@ func TestRemoveDupsAndSortByLexicalOrderArrayOf32Bytes(t *testing.T) {
 	}
 }
 
+func TestSortOutputsLexically(t *testing.T) {
+	outputs := iotago.Serializables{}
+	for i := 0; i < 10; i++ {
+		output, _ := tpkg.RandSigLockedSingleOutput(iotago.AddressEd25519)
+		outputs = append(outputs, output)
+	}
+	assert.NoError(t, iotago.SortOutputsLexically(outputs))
+
+	var prev []byte
+	for _, output := range outputs {
+		data, err := output.Serialize(iotago.DeSeriModeNoValidation)
+		assert.NoError(t, err)
+		assert.True(t, bytes.Compare(prev, data) < 0)
+		prev = data
+	}
+}
+
+func TestSortInputsLexically(t *testing.T) {
+	inputs := iotago.Serializables{}
+	for i := 0; i < 10; i++ {
+		input, _ := tpkg.RandUTXOInput()
+		inputs = append(inputs, input)
+	}
+	assert.NoError(t, iotago.SortInputsLexically(inputs))
+
+	var prev []byte
+	for _, input := range inputs {
+		data, err := input.Serialize(iotago.DeSeriModeNoValidation)
+		assert.NoError(t, err)
+		assert.True(t, bytes.Compare(prev, data) < 0)
+		prev = data
+	}
+}
+
 func TestSerializationMode_HasMode(t *testing.T) {
 	type args struct {
 		mode iotago.DeSerializationMode
@@ -3,6 +3,7 @@ package iotago
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
 )
 
@@ -16,12 +17,18 @@ type (
 	// ArrayOf49Bytes is an array of 49 bytes.
 	ArrayOf49Bytes = [49]byte
 
+	// ArrayOf33Bytes is an array of 33 bytes.
+	ArrayOf33Bytes = [33]byte
+
 	// SliceOfArraysOf32Bytes is a slice of arrays of which each is 32 bytes.
 	SliceOfArraysOf32Bytes = []ArrayOf32Bytes
 
 	// SliceOfArraysOf64Bytes is a slice of arrays of which each is 64 bytes.
 	SliceOfArraysOf64Bytes = []ArrayOf64Bytes
 
+	// SliceOfArraysOf33Bytes is a slice of arrays of which each is 33 bytes.
+	SliceOfArraysOf33Bytes = []ArrayOf33Bytes
+
 	// ErrProducer produces an error.
 	ErrProducer func(err error) error
 
@@ -251,6 +258,43 @@ func (s *Serializer) Write64BytesArraySlice(data SliceOfArraysOf64Bytes, deSeriM
 	return s
 }
 
+// Write33BytesArraySlice writes a slice of arrays of 33 bytes to the Serializer.
+func (s *Serializer) Write33BytesArraySlice(data SliceOfArraysOf33Bytes, deSeriMode DeSerializationMode, lenType SeriSliceLengthType, arrayRules *ArrayRules, errProducer ErrProducer) *Serializer {
+	if s.err != nil {
+		return s
+	}
+
+	sliceLength := len(data)
+
+	var arrayElementValidator ElementValidationFunc
+	if arrayRules != nil && deSeriMode.HasMode(DeSeriModePerformValidation) {
+		if err := arrayRules.CheckBounds(uint16(sliceLength)); err != nil {
+			s.err = errProducer(err)
+			return s
+		}
+
+		arrayElementValidator = arrayRules.ElementValidationFunc(arrayRules.ValidationMode)
+	}
+
+	_ = s.writeSliceLength(sliceLength, lenType, errProducer)
+	for i := range data {
+		element := data[i][:]
+
+		if arrayElementValidator != nil {
+			if err := arrayElementValidator(i, element); err != nil {
+				s.err = errProducer(err)
+				return s
+			}
+		}
+
+		if _, err := s.buf.Write(element); err != nil {
+			s.err = errProducer(err)
+			return s
+		}
+	}
+	return s
+}
+
 // WriteObject writes the given Serializable to the Serializer.
 func (s *Serializer) WriteObject(seri Serializable, deSeriMode DeSerializationMode, errProducer ErrProducer) *Serializer {
 	if s.err != nil {
@@ -548,6 +592,26 @@ func (d *Deserializer) ReadArrayOf49Bytes(arr *ArrayOf49Bytes, errProducer ErrPr
 	return d
 }
 
+// ReadArrayOf33Bytes reads an array of 33 bytes.
+func (d *Deserializer) ReadArrayOf33Bytes(arr *ArrayOf33Bytes, errProducer ErrProducer) *Deserializer {
+	if d.err != nil {
+		return d
+	}
+	const length = 33
+
+	l := len(d.src)
+	if l < length {
+		d.err = errProducer(ErrDeserializationNotEnoughData)
+		return d
+	}
+
+	copy(arr[:], d.src[:length])
+	d.offset += length
+	d.src = d.src[length:]
+
+	return d
+}
+
 // reads the length of a slice.
 func (d *Deserializer) readSliceLength(lenType SeriSliceLengthType, errProducer ErrProducer) (int, error) {
 	l := len(d.src)
@@ -680,6 +744,53 @@ func (d *Deserializer) ReadSliceOfArraysOf64Bytes(slice *SliceOfArraysOf64Bytes,
 	return d
 }
 
+// ReadSliceOfArraysOf33Bytes reads a slice of arrays of 33 bytes.
+func (d *Deserializer) ReadSliceOfArraysOf33Bytes(slice *SliceOfArraysOf33Bytes, deSeriMode DeSerializationMode, lenType SeriSliceLengthType, arrayRules *ArrayRules, errProducer ErrProducer) *Deserializer {
+	if d.err != nil {
+		return d
+	}
+	const length = 33
+
+	sliceLength, err := d.readSliceLength(lenType, errProducer)
+	if err != nil {
+		d.err = err
+		return d
+	}
+
+	var arrayElementValidator ElementValidationFunc
+	if arrayRules != nil && deSeriMode.HasMode(DeSeriModePerformValidation) {
+		if err := arrayRules.CheckBounds(uint16(sliceLength)); err != nil {
+			d.err = errProducer(err)
+			return d
+		}
+
+		arrayElementValidator = arrayRules.ElementValidationFunc(arrayRules.ValidationMode)
+	}
+
+	s := make(SliceOfArraysOf33Bytes, sliceLength)
+	for i := 0; i < sliceLength; i++ {
+		if len(d.src) < length {
+			d.err = errProducer(ErrDeserializationNotEnoughData)
+			return d
+		}
+
+		if arrayElementValidator != nil {
+			if err := arrayElementValidator(i, d.src[:length]); err != nil {
+				d.err = errProducer(err)
+				return d
+			}
+		}
+
+		copy(s[i][:], d.src[:length])
+		d.offset += length
+		d.src = d.src[length:]
+	}
+
+	*slice = s
+
+	return d
+}
+
 // ReadObject reads an object, using the given SerializableSelectorFunc.
 func (d *Deserializer) ReadObject(f ReadObjectConsumerFunc, deSeriMode DeSerializationMode, typeDen TypeDenotationType, serSel SerializableSelectorFunc, errProducer ErrProducer) *Deserializer {
 	if d.err != nil {
@@ -819,13 +930,19 @@ func (d *Deserializer) ReadPayload(f ReadObjectConsumerFunc, deSeriMode DeSerial
 		sel = selector[0]
 	}
 
+	payloadData := d.src
 	payload, err := sel(binary.LittleEndian.Uint32(d.src))
 	if err != nil {
-		d.err = errProducer(err)
-		return d
+		if !deSeriMode.HasMode(DeSeriModeTolerateUnknownPayload) || !errors.Is(err, ErrUnknownPayloadType) {
+			d.err = errProducer(err)
+			return d
+		}
+		// an unknown payload can only be delimited by its denoted length
+		payload = &RawPayload{}
+		payloadData = d.src[:payloadLength]
 	}
 
-	payloadBytesConsumed, err := payload.Deserialize(d.src, deSeriMode)
+	payloadBytesConsumed, err := payload.Deserialize(payloadData, deSeriMode)
 	if err != nil {
 		d.err = errProducer(err)
 		return d
@@ -915,3 +1032,13 @@ func (d *Deserializer) ConsumedAll(errProducer ErrProducerWithLeftOver) *Deseria
 func (d *Deserializer) Done() (int, error) {
 	return d.offset, d.err
 }
+
+// RemainingBytes returns the amount of bytes which have not yet been consumed from the Deserializer's src.
+func (d *Deserializer) RemainingBytes() int {
+	return len(d.src)
+}
+
+// Offset returns the amount of bytes consumed so far, which is the offset of the next byte to be read.
+func (d *Deserializer) Offset() int {
+	return d.offset
+}
@@ -3,6 +3,7 @@ package iotago_test
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"testing"
 
 	"github.com/iotaledger/iota.go/v2"
@@ -108,3 +109,91 @@ func TestDeserializer_ReadString(t *testing.T) {
 		})
 	}
 }
+
+func TestSerializer_WriteBool_Deserializer_ReadBool(t *testing.T) {
+	data, err := iotago.NewSerializer().
+		WriteBool(true, func(err error) error { return err }).
+		WriteBool(false, func(err error) error { return err }).
+		Serialize()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1, 0}, data)
+
+	var first, second bool
+	bytesRead, err := iotago.NewDeserializer(data).
+		ReadBool(&first, func(err error) error { return err }).
+		ReadBool(&second, func(err error) error { return err }).
+		ConsumedAll(func(left int, err error) error { return err }).
+		Done()
+	assert.NoError(t, err)
+	assert.Equal(t, len(data), bytesRead)
+	assert.True(t, first)
+	assert.False(t, second)
+
+	var invalid bool
+	_, err = iotago.NewDeserializer([]byte{2}).
+		ReadBool(&invalid, func(err error) error { return err }).
+		Done()
+	assert.True(t, errors.Is(err, iotago.ErrDeserializationInvalidBoolValue))
+
+	_, err = iotago.NewDeserializer([]byte{}).
+		ReadBool(&invalid, func(err error) error { return err }).
+		Done()
+	assert.True(t, errors.Is(err, iotago.ErrDeserializationNotEnoughData))
+}
+
+func TestSerializer_Write33BytesArraySlice_Deserializer_ReadSliceOfArraysOf33Bytes(t *testing.T) {
+	origin := iotago.SliceOfArraysOf33Bytes{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+
+	data, err := iotago.NewSerializer().
+		Write33BytesArraySlice(origin, iotago.DeSeriModePerformValidation, iotago.SeriSliceLengthAsByte, nil, func(err error) error { return err }).
+		Serialize()
+	assert.NoError(t, err)
+	assert.Len(t, data, iotago.OneByte+len(origin)*33)
+
+	var read iotago.SliceOfArraysOf33Bytes
+	bytesRead, err := iotago.NewDeserializer(data).
+		ReadSliceOfArraysOf33Bytes(&read, iotago.DeSeriModePerformValidation, iotago.SeriSliceLengthAsByte, nil, func(err error) error { return err }).
+		ConsumedAll(func(left int, err error) error { return err }).
+		Done()
+	assert.NoError(t, err)
+	assert.Equal(t, len(data), bytesRead)
+	assert.Equal(t, origin, read)
+
+	var arr iotago.ArrayOf33Bytes
+	bytesRead, err = iotago.NewDeserializer(data[iotago.OneByte:]).
+		ReadArrayOf33Bytes(&arr, func(err error) error { return err }).
+		Done()
+	assert.NoError(t, err)
+	assert.Equal(t, 33, bytesRead)
+	assert.Equal(t, origin[0], arr)
+
+	_, err = iotago.NewDeserializer(make([]byte, 32)).
+		ReadArrayOf33Bytes(&arr, func(err error) error { return err }).
+		Done()
+	assert.True(t, errors.Is(err, iotago.ErrDeserializationNotEnoughData))
+}
+
+func TestDeserializer_RemainingBytes_Offset(t *testing.T) {
+	data := []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+
+	var num uint32
+	var remaining, offset int
+	d := iotago.NewDeserializer(data)
+	assert.Equal(t, len(data), d.RemainingBytes())
+	assert.Zero(t, d.Offset())
+
+	bytesRead, err := d.
+		ReadNum(&num, func(err error) error { return err }).
+		Do(func() {
+			remaining = d.RemainingBytes()
+			offset = d.Offset()
+		}).
+		Skip(2, func(err error) error { return err }).
+		Done()
+	assert.NoError(t, err)
+	assert.Equal(t, len(data)-iotago.UInt32ByteSize, remaining)
+	assert.Equal(t, iotago.UInt32ByteSize, offset)
+	assert.Equal(t, iotago.UInt32ByteSize+2, bytesRead)
+	assert.Equal(t, bytesRead, d.Offset())
+	assert.Equal(t, len(data)-bytesRead, d.RemainingBytes())
+}
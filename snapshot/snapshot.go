@@ -0,0 +1,198 @@
+// Package snapshot provides reading and writing of ledger state snapshots consisting of unspent outputs.
+//
+// A snapshot is serialized as a header followed by the output entries:
+//
+//	version (1 byte) | milestone index (4 bytes) | milestone timestamp (8 bytes) | output count (8 bytes)
+//
+// where each output entry consists of:
+//
+//	message ID (32 bytes) | output ID (34 bytes) | output length (2 bytes) | serialized output
+//
+// All numbers are encoded in little endian.
+package snapshot
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/iotaledger/iota.go/v2"
+)
+
+const (
+	// SupportedFormatVersion defines the snapshot format version supported by this package.
+	SupportedFormatVersion byte = 1
+)
+
+var (
+	// ErrUnsupportedFormatVersion gets returned when a snapshot's format version is not SupportedFormatVersion.
+	ErrUnsupportedFormatVersion = errors.New("unsupported snapshot format version")
+	// ErrInvalidOutput gets returned when an output entry does not hold a valid output.
+	ErrInvalidOutput = errors.New("invalid snapshot output")
+)
+
+// Header is the header of a snapshot.
+type Header struct {
+	// The version of the snapshot format.
+	Version byte
+	// The index of the milestone up to which the ledger state is represented.
+	MilestoneIndex uint32
+	// The unix timestamp of the milestone.
+	Timestamp uint64
+	// The amount of output entries within the snapshot.
+	OutputCount uint64
+}
+
+// Output is an unspent output within a snapshot.
+type Output struct {
+	// The ID of the message which contained the transaction creating the output.
+	MessageID iotago.MessageID
+	// The ID of the output.
+	OutputID iotago.UTXOInputID
+	// The output itself.
+	Output iotago.Output
+}
+
+// HeaderConsumerFunc consumes the snapshot header.
+type HeaderConsumerFunc func(header *Header) error
+
+// OutputConsumerFunc consumes an output entry of the snapshot.
+// Returning an error aborts the reading of the snapshot.
+type OutputConsumerFunc func(output *Output) error
+
+// StreamRead reads a snapshot from the given reader and passes its header and each output entry,
+// in the order they are stored, to the given consumers.
+func StreamRead(r io.Reader, headerConsumer HeaderConsumerFunc, outputConsumer OutputConsumerFunc) error {
+	header := &Header{}
+	if err := binary.Read(r, binary.LittleEndian, header); err != nil {
+		return fmt.Errorf("unable to read snapshot header: %w", err)
+	}
+
+	if header.Version != SupportedFormatVersion {
+		return fmt.Errorf("%w: version %d, supported %d", ErrUnsupportedFormatVersion, header.Version, SupportedFormatVersion)
+	}
+
+	if err := headerConsumer(header); err != nil {
+		return err
+	}
+
+	for i := uint64(0); i < header.OutputCount; i++ {
+		output, err := readOutput(r)
+		if err != nil {
+			return fmt.Errorf("unable to read snapshot output at pos %d: %w", i, err)
+		}
+		if err := outputConsumer(output); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Read reads an entire snapshot from the given reader.
+func Read(r io.Reader) (*Header, []*Output, error) {
+	var header *Header
+	var outputs []*Output
+	if err := StreamRead(r, func(h *Header) error {
+		// the output count is not preallocated as it stems from untrusted input
+		header = h
+		return nil
+	}, func(output *Output) error {
+		outputs = append(outputs, output)
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	return header, outputs, nil
+}
+
+// Write writes a snapshot consisting of the given header and outputs to the given writer.
+// The header's version and output count are set by Write.
+func Write(w io.Writer, header *Header, outputs []*Output) error {
+	header.Version = SupportedFormatVersion
+	header.OutputCount = uint64(len(outputs))
+	if err := binary.Write(w, binary.LittleEndian, header); err != nil {
+		return fmt.Errorf("unable to write snapshot header: %w", err)
+	}
+
+	for i, output := range outputs {
+		if err := writeOutput(w, output); err != nil {
+			return fmt.Errorf("unable to write snapshot output at pos %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+func readOutput(r io.Reader) (*Output, error) {
+	output := &Output{}
+	if _, err := io.ReadFull(r, output.MessageID[:]); err != nil {
+		return nil, fmt.Errorf("unable to read message ID: %w", err)
+	}
+
+	if _, err := io.ReadFull(r, output.OutputID[:]); err != nil {
+		return nil, fmt.Errorf("unable to read output ID: %w", err)
+	}
+
+	var outputLength uint16
+	if err := binary.Read(r, binary.LittleEndian, &outputLength); err != nil {
+		return nil, fmt.Errorf("unable to read output length: %w", err)
+	}
+
+	outputData := make([]byte, outputLength)
+	if _, err := io.ReadFull(r, outputData); err != nil {
+		return nil, fmt.Errorf("unable to read output: %w", err)
+	}
+
+	if len(outputData) == 0 {
+		return nil, fmt.Errorf("%w: empty output", ErrInvalidOutput)
+	}
+
+	seri, err := iotago.OutputSelector(uint32(outputData[0]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOutput, err)
+	}
+
+	bytesRead, err := seri.Deserialize(outputData, iotago.DeSeriModePerformValidation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidOutput, err)
+	}
+
+	if bytesRead != len(outputData) {
+		return nil, fmt.Errorf("%w: denoted output length (%d) doesn't equal the size of the deserialized output (%d)", ErrInvalidOutput, len(outputData), bytesRead)
+	}
+
+	out, ok := seri.(iotago.Output)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T is not an output with a deposit", ErrInvalidOutput, seri)
+	}
+
+	output.Output = out
+	return output, nil
+}
+
+func writeOutput(w io.Writer, output *Output) error {
+	outputData, err := output.Output.Serialize(iotago.DeSeriModePerformValidation)
+	if err != nil {
+		return fmt.Errorf("unable to serialize output: %w", err)
+	}
+
+	if _, err := w.Write(output.MessageID[:]); err != nil {
+		return fmt.Errorf("unable to write message ID: %w", err)
+	}
+
+	if _, err := w.Write(output.OutputID[:]); err != nil {
+		return fmt.Errorf("unable to write output ID: %w", err)
+	}
+
+	if err := binary.Write(w, binary.LittleEndian, uint16(len(outputData))); err != nil {
+		return fmt.Errorf("unable to write output length: %w", err)
+	}
+
+	if _, err := w.Write(outputData); err != nil {
+		return fmt.Errorf("unable to write output: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota.go/v2"
+	"github.com/iotaledger/iota.go/v2/snapshot"
+	"github.com/iotaledger/iota.go/v2/tpkg"
+)
+
+func randSnapshotOutput(output iotago.Output) *snapshot.Output {
+	snapshotOutput := &snapshot.Output{MessageID: tpkg.Rand32ByteArray(), Output: output}
+	copy(snapshotOutput.OutputID[:], tpkg.RandBytes(len(snapshotOutput.OutputID)))
+	return snapshotOutput
+}
+
+func TestWriteRead(t *testing.T) {
+	addr, _ := tpkg.RandEd25519Address()
+	outputs := []*snapshot.Output{
+		randSnapshotOutput(&iotago.SigLockedSingleOutput{Address: addr, Amount: 1337}),
+		randSnapshotOutput(&iotago.SigLockedDustAllowanceOutput{Address: addr, Amount: iotago.OutputSigLockedDustAllowanceOutputMinDeposit}),
+		randSnapshotOutput(&iotago.SigLockedSingleOutput{Address: addr, Amount: 1}),
+	}
+	header := &snapshot.Header{MilestoneIndex: 1000, Timestamp: 1609459200}
+
+	var buf bytes.Buffer
+	require.NoError(t, snapshot.Write(&buf, header, outputs))
+	assert.Equal(t, snapshot.SupportedFormatVersion, header.Version)
+	assert.EqualValues(t, len(outputs), header.OutputCount)
+
+	readHeader, readOutputs, err := snapshot.Read(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, header, readHeader)
+	assert.Equal(t, outputs, readOutputs)
+
+	var iterated int
+	errStop := errors.New("stop")
+	err = snapshot.StreamRead(bytes.NewReader(buf.Bytes()), func(*snapshot.Header) error { return nil }, func(output *snapshot.Output) error {
+		assert.Equal(t, outputs[iterated], output)
+		iterated++
+		if iterated == 2 {
+			return errStop
+		}
+		return nil
+	})
+	assert.True(t, errors.Is(err, errStop))
+	assert.Equal(t, 2, iterated)
+}
+
+func TestRead_Errors(t *testing.T) {
+	addr, _ := tpkg.RandEd25519Address()
+	outputs := []*snapshot.Output{randSnapshotOutput(&iotago.SigLockedSingleOutput{Address: addr, Amount: 1337})}
+
+	var buf bytes.Buffer
+	require.NoError(t, snapshot.Write(&buf, &snapshot.Header{}, outputs))
+	data := buf.Bytes()
+
+	_, _, err := snapshot.Read(bytes.NewReader(data[:len(data)-1]))
+	assert.Error(t, err)
+
+	unsupportedVersion := append([]byte{}, data...)
+	unsupportedVersion[0] = snapshot.SupportedFormatVersion + 1
+	_, _, err = snapshot.Read(bytes.NewReader(unsupportedVersion))
+	assert.True(t, errors.Is(err, snapshot.ErrUnsupportedFormatVersion))
+
+	// a header denoting a huge amount of outputs must not be trusted for allocations
+	hugeOutputCount := append([]byte{}, data[:1+4+8]...)
+	hugeOutputCount = append(hugeOutputCount, 0, 0, 0, 0, 0, 0, 0, 0x10)
+	_, _, err = snapshot.Read(bytes.NewReader(hugeOutputCount))
+	assert.Error(t, err)
+
+	// replace the output type (after the header, message ID, output ID and output length) with the one of a treasury output
+	treasuryOutput := append([]byte{}, data...)
+	treasuryOutput[1+4+8+8+32+34+2] = iotago.OutputTreasuryOutput
+	_, _, err = snapshot.Read(bytes.NewReader(treasuryOutput))
+	assert.True(t, errors.Is(err, snapshot.ErrInvalidOutput))
+}
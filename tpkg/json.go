@@ -0,0 +1,56 @@
+package tpkg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/iotaledger/iota.go/v2"
+)
+
+// JSONRoundTripEqual marshals the given Serializable to JSON, unmarshals it back into a fresh instance
+// of the same type and then checks whether both objects serialize to the same bytes and marshal to the same JSON.
+// It returns an error describing the first step which failed or if the serialized forms differ.
+func JSONRoundTripEqual(seri iotago.Serializable) error {
+	originBytes, err := seri.Serialize(iotago.DeSeriModeNoValidation)
+	if err != nil {
+		return fmt.Errorf("unable to serialize origin: %w", err)
+	}
+
+	jsonBytes, err := json.Marshal(seri)
+	if err != nil {
+		return fmt.Errorf("unable to marshal origin to JSON: %w", err)
+	}
+
+	seriType := reflect.TypeOf(seri)
+	if seriType.Kind() != reflect.Ptr {
+		return fmt.Errorf("serializable of type %T must be a pointer", seri)
+	}
+	decoded, ok := reflect.New(seriType.Elem()).Interface().(iotago.Serializable)
+	if !ok {
+		return fmt.Errorf("unable to create a new instance of type %T", seri)
+	}
+	if err := json.Unmarshal(jsonBytes, decoded); err != nil {
+		return fmt.Errorf("unable to unmarshal JSON: %w", err)
+	}
+
+	decodedBytes, err := decoded.Serialize(iotago.DeSeriModeNoValidation)
+	if err != nil {
+		return fmt.Errorf("unable to serialize object decoded from JSON: %w", err)
+	}
+
+	if !bytes.Equal(originBytes, decodedBytes) {
+		return fmt.Errorf("serialized form differs after JSON round trip of %T: origin %x, decoded %x", seri, originBytes, decodedBytes)
+	}
+
+	decodedJSONBytes, err := json.Marshal(decoded)
+	if err != nil {
+		return fmt.Errorf("unable to marshal object decoded from JSON: %w", err)
+	}
+
+	if !bytes.Equal(jsonBytes, decodedJSONBytes) {
+		return fmt.Errorf("JSON differs after JSON round trip of %T: origin %s, decoded %s", seri, jsonBytes, decodedJSONBytes)
+	}
+	return nil
+}
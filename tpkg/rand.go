@@ -0,0 +1,41 @@
+package tpkg
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+var (
+	// guards rng, as a rand.Rand is not safe for concurrent use.
+	rngMu sync.Mutex
+	// the source of randomness of all random generators within this package.
+	rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// Seed seeds the random number generator used by the random generators of this package.
+// After seeding, the same sequence of calls to the generators produces the same objects,
+// as long as they are not called concurrently.
+func Seed(seed int64) {
+	rngMu.Lock()
+	defer rngMu.Unlock()
+	rng = rand.New(rand.NewSource(seed))
+}
+
+func randIntn(n int) int {
+	rngMu.Lock()
+	defer rngMu.Unlock()
+	return rng.Intn(n)
+}
+
+func randUint64() uint64 {
+	rngMu.Lock()
+	defer rngMu.Unlock()
+	return rng.Uint64()
+}
+
+func randRead(b []byte) (int, error) {
+	rngMu.Lock()
+	defer rngMu.Unlock()
+	return rng.Read(b)
+}
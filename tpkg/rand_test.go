@@ -0,0 +1,33 @@
+package tpkg_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota.go/v2"
+	"github.com/iotaledger/iota.go/v2/tpkg"
+)
+
+func randMessagesData(seed int64) [][]byte {
+	tpkg.Seed(seed)
+	var data [][]byte
+	for _, payloadType := range []uint32{iotago.TransactionPayloadTypeID, iotago.MilestonePayloadTypeID, iotago.IndexationPayloadTypeID} {
+		_, msgData := tpkg.RandMessage(payloadType)
+		data = append(data, msgData)
+	}
+	return data
+}
+
+func TestSeed(t *testing.T) {
+	msgsData := randMessagesData(1337)
+	assert.Equal(t, msgsData, randMessagesData(1337))
+	assert.NotEqual(t, msgsData, randMessagesData(1338))
+
+	for _, msgData := range msgsData {
+		msg := &iotago.Message{}
+		_, err := msg.Deserialize(msgData, iotago.DeSeriModePerformValidation)
+		require.NoError(t, err)
+	}
+}
@@ -4,10 +4,9 @@ import (
 	"bytes"
 	"encoding/binary"
 	"fmt"
-	"math/rand"
+	"math"
 	"sort"
 	"strings"
-	"time"
 
 	legacy "github.com/iotaledger/iota.go/consts"
 	"github.com/iotaledger/iota.go/trinary"
@@ -26,7 +25,7 @@ func Must(err error) {
 func RandBytes(length int) []byte {
 	var b []byte
 	for i := 0; i < length; i++ {
-		b = append(b, byte(rand.Intn(256)))
+		b = append(b, byte(randIntn(256)))
 	}
 	return b
 }
@@ -35,7 +34,7 @@ func RandBytes(length int) []byte {
 func RandTrytes(length int) trinary.Trytes {
 	var trytes strings.Builder
 	for i := 0; i < length; i++ {
-		trytes.WriteByte(legacy.TryteAlphabet[rand.Intn(len(legacy.TryteAlphabet))])
+		trytes.WriteByte(legacy.TryteAlphabet[randIntn(len(legacy.TryteAlphabet))])
 	}
 	return trytes.String()
 }
@@ -112,7 +111,7 @@ func RandEd25519SignatureUnlockBlock() (*iotago.SignatureUnlockBlock, []byte) {
 
 // RandReferenceUnlockBlock returns a random reference unlock block.
 func RandReferenceUnlockBlock() (*iotago.ReferenceUnlockBlock, []byte) {
-	return ReferenceUnlockBlock(uint16(rand.Intn(1000)))
+	return ReferenceUnlockBlock(uint16(randIntn(1000)))
 }
 
 // ReferenceUnlockBlock returns a reference unlock block with the given index.
@@ -131,7 +130,7 @@ func RandTransactionEssence() (*iotago.TransactionEssence, []byte) {
 	Must(buf.WriteByte(iotago.TransactionEssenceNormal))
 
 	inputsBytes := iotago.LexicalOrderedByteSlices{}
-	inputCount := rand.Intn(10) + 1
+	inputCount := randIntn(10) + 1
 	Must(binary.Write(&buf, binary.LittleEndian, uint16(inputCount)))
 	for i := inputCount; i > 0; i-- {
 		_, inputData := RandUTXOInput()
@@ -151,7 +150,7 @@ func RandTransactionEssence() (*iotago.TransactionEssence, []byte) {
 	}
 
 	outputsBytes := iotago.LexicalOrderedByteSlices{}
-	outputCount := rand.Intn(10) + 1
+	outputCount := randIntn(10) + 1
 	Must(binary.Write(&buf, binary.LittleEndian, uint16(outputCount)))
 	for i := outputCount; i > 0; i-- {
 		_, depData := RandSigLockedSingleOutput(iotago.AddressEd25519)
@@ -179,7 +178,7 @@ func RandTransactionEssence() (*iotago.TransactionEssence, []byte) {
 func RandMigratedFundsEntry() (*iotago.MigratedFundsEntry, []byte) {
 	tailTxHash := Rand49ByteArray()
 	addr, addrBytes := RandEd25519Address()
-	deposit := rand.Uint64()
+	deposit := randUint64()
 
 	var b bytes.Buffer
 	_, err := b.Write(tailTxHash[:])
@@ -206,7 +205,7 @@ func RandReceipt() (*iotago.Receipt, []byte) {
 	Must(b.WriteByte(1))
 
 	migFundsEntriesBytes := iotago.LexicalOrderedByteSlices{}
-	migFundsEntriesCount := rand.Intn(10) + 1
+	migFundsEntriesCount := randIntn(10) + 1
 	Must(binary.Write(&b, binary.LittleEndian, uint16(migFundsEntriesCount)))
 	for i := migFundsEntriesCount; i > 0; i-- {
 		_, migFundsEntryBytes := RandMigratedFundsEntry()
@@ -242,12 +241,12 @@ func RandMilestone(parents iotago.MessageIDs) (*iotago.Milestone, []byte) {
 	const sigsCount = 3
 
 	if parents == nil {
-		parents = SortedRand32BytArray(1 + rand.Intn(7))
+		parents = SortedRand32BytArray(1 + randIntn(7))
 	}
 
 	msPayload := &iotago.Milestone{
-		Index:     uint32(rand.Intn(1000)),
-		Timestamp: uint64(time.Now().Unix()),
+		Index:     uint32(randIntn(1000)),
+		Timestamp: uint64(randIntn(math.MaxInt32)),
 		Parents:   parents,
 		InclusionMerkleProof: func() [iotago.MilestoneInclusionMerkleProofLength]byte {
 			b := [iotago.MilestoneInclusionMerkleProofLength]byte{}
@@ -322,7 +321,7 @@ func RandIndexation(dataLength ...int) (*iotago.Indexation, []byte) {
 	case len(dataLength) > 0:
 		data = RandBytes(dataLength[0])
 	default:
-		data = RandBytes(rand.Intn(200) + 1)
+		data = RandBytes(randIntn(200) + 1)
 	}
 
 	indexationPayload := &iotago.Indexation{Index: []byte(index), Data: data}
@@ -350,7 +349,7 @@ func RandMessage(withPayloadType uint32) (*iotago.Message, []byte) {
 	var payload iotago.Serializable
 	var payloadData []byte
 
-	parents := SortedRand32BytArray(1 + rand.Intn(7))
+	parents := SortedRand32BytArray(1 + randIntn(7))
 
 	switch withPayloadType {
 	case iotago.TransactionPayloadTypeID:
@@ -364,7 +363,7 @@ func RandMessage(withPayloadType uint32) (*iotago.Message, []byte) {
 	m := &iotago.Message{}
 	m.NetworkID = 1
 	m.Payload = payload
-	m.Nonce = uint64(rand.Intn(1000))
+	m.Nonce = uint64(randIntn(1000))
 	m.Parents = parents
 
 	var b bytes.Buffer
@@ -437,7 +436,7 @@ func RandUTXOInput() (*iotago.UTXOInput, []byte) {
 	copy(b[iotago.SmallTypeDenotationByteSize:], txID)
 	copy(utxoInput.TransactionID[:], txID)
 
-	index := uint16(rand.Intn(iotago.RefUTXOIndexMax))
+	index := uint16(randIntn(iotago.RefUTXOIndexMax))
 	binary.LittleEndian.PutUint16(b[len(b)-iotago.UInt16ByteSize:], index)
 	utxoInput.TransactionOutputIndex = index
 	return utxoInput, b[:]
@@ -447,7 +446,7 @@ func RandUTXOInput() (*iotago.UTXOInput, []byte) {
 func RandTreasuryOutput() (*iotago.TreasuryOutput, []byte) {
 	var b bytes.Buffer
 
-	deposit := rand.Uint64()
+	deposit := randUint64()
 	Must(binary.Write(&b, binary.LittleEndian, iotago.OutputTreasuryOutput))
 	Must(binary.Write(&b, binary.LittleEndian, deposit))
 
@@ -489,7 +488,32 @@ func RandSigLockedSingleOutput(addrType iotago.AddressType) (*iotago.SigLockedSi
 	_, err := buf.Write(addrData)
 	Must(err)
 
-	amount := uint64(rand.Intn(10000))
+	amount := uint64(randIntn(10000))
+	Must(binary.Write(&buf, binary.LittleEndian, amount))
+	dep.Amount = amount
+
+	return dep, buf.Bytes()
+}
+
+// RandSigLockedDustAllowanceOutput returns a random signature locked dust allowance output.
+func RandSigLockedDustAllowanceOutput(addrType iotago.AddressType) (*iotago.SigLockedDustAllowanceOutput, []byte) {
+	var buf bytes.Buffer
+	Must(buf.WriteByte(iotago.OutputSigLockedDustAllowanceOutput))
+
+	dep := &iotago.SigLockedDustAllowanceOutput{}
+
+	var addrData []byte
+	switch addrType {
+	case iotago.AddressEd25519:
+		dep.Address, addrData = RandEd25519Address()
+	default:
+		panic(fmt.Sprintf("invalid addr type: %d", addrType))
+	}
+
+	_, err := buf.Write(addrData)
+	Must(err)
+
+	amount := iotago.OutputSigLockedDustAllowanceOutputMinDeposit + uint64(randIntn(10000))
 	Must(binary.Write(&buf, binary.LittleEndian, amount))
 	dep.Amount = amount
 
@@ -541,7 +565,7 @@ func RandEd25519PrivateKey() ed25519.PrivateKey {
 // RandEd25519Seed returns a random Ed25519 seed.
 func RandEd25519Seed() [ed25519.SeedSize]byte {
 	var b [ed25519.SeedSize]byte
-	read, err := rand.Read(b[:])
+	read, err := randRead(b[:])
 	if read != ed25519.SeedSize {
 		panic(fmt.Sprintf("could not read %d required bytes from secure RNG", ed25519.SeedSize))
 	}
@@ -208,6 +208,23 @@ type SemanticValidationFunc = func(t *Transaction, utxos InputToOutputMapping) e
 // DustAllowanceFunc returns the deposit sum of dust allowance outputs and amount of dust outputs on the given address.
 type DustAllowanceFunc func(addr Address) (dustAllowanceSum uint64, amountDustOutputs int64, err error)
 
+// AggregateDustState sums up the deposits of the SigLockedDustAllowanceOutput(s) and counts the dust outputs, which are
+// SigLockedSingleOutput(s) depositing less than OutputSigLockedDustAllowanceOutputMinDeposit, within the given outputs.
+// Given the outputs residing on an address, the results correspond to the ones of a DustAllowanceFunc for that address.
+func AggregateDustState(outputs Serializables) (allowance uint64, dustCount int) {
+	for _, output := range outputs {
+		switch out := output.(type) {
+		case *SigLockedDustAllowanceOutput:
+			allowance += out.Amount
+		case *SigLockedSingleOutput:
+			if out.Amount < OutputSigLockedDustAllowanceOutputMinDeposit {
+				dustCount++
+			}
+		}
+	}
+	return allowance, dustCount
+}
+
 // NewDustSemanticValidation returns a SemanticValidationFunc which verifies whether
 // a transaction fulfils the semantics regarding dust outputs:
 //	A transaction:
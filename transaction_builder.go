@@ -1,9 +1,11 @@
 package iotago
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 )
 
 var (
@@ -54,20 +56,31 @@ type TransactionBuilderInputFilter func(utxoInput *UTXOInput, input Output) bool
 // AddInputsViaNodeQuery adds any unspent outputs by the given address as an input to the built transaction
 // if it passes the filter function. It is the caller's job to ensure that the limit of returned outputs on the queried
 // node is enough high for the application's purpose. filter can be nil.
-func (b *TransactionBuilder) AddInputsViaNodeQuery(ctx context.Context, addr Address, nodeHTTPAPIClient *NodeHTTPAPIClient, filter TransactionBuilderInputFilter) *TransactionBuilder {
+func (b *TransactionBuilder) AddInputsViaNodeQuery(ctx context.Context, addr Address, nodeAPI NodeAPIClient, filter TransactionBuilderInputFilter) *TransactionBuilder {
 	switch x := addr.(type) {
 	case *Ed25519Address:
 	default:
 		b.occurredBuildErr = fmt.Errorf("%w: auto. inputs via node query only supports Ed25519Address but got %T", ErrTransactionBuilderUnsupportedAddress, x)
 	}
 
-	_, unspentOutputs, err := nodeHTTPAPIClient.OutputsByEd25519Address(ctx, addr.(*Ed25519Address), false)
+	_, unspentOutputs, err := nodeAPI.OutputsByEd25519Address(ctx, addr.(*Ed25519Address), false)
 	if err != nil {
 		b.occurredBuildErr = err
 		return b
 	}
 
-	for utxoInput, output := range unspentOutputs {
+	// iterate in a canonical order so that the selection of inputs does not depend on map iteration order
+	utxoInputs := make([]*UTXOInput, 0, len(unspentOutputs))
+	for utxoInput := range unspentOutputs {
+		utxoInputs = append(utxoInputs, utxoInput)
+	}
+	sort.Slice(utxoInputs, func(i, j int) bool {
+		iID, jID := utxoInputs[i].ID(), utxoInputs[j].ID()
+		return bytes.Compare(iID[:], jID[:]) < 0
+	})
+
+	for _, utxoInput := range utxoInputs {
+		output := unspentOutputs[utxoInput]
 		if filter != nil && !filter(utxoInput, output) {
 			continue
 		}
@@ -115,7 +128,8 @@ func (b *TransactionBuilder) Build(signer AddressSigner) (*Transaction, error) {
 		return nil, b.occurredBuildErr
 	}
 
-	// sort inputs and outputs by their serialized byte order
+	// computing the signing message sorts the inputs and outputs by their serialized byte order,
+	// which the unlock blocks below rely on
 	txEssenceData, err := b.essence.SigningMessage()
 	if err != nil {
 		return nil, err
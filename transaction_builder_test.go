@@ -1,8 +1,10 @@
 package iotago_test
 
 import (
+	"context"
 	"errors"
 	"github.com/iotaledger/iota.go/v2/tpkg"
+	"math/rand"
 	"testing"
 
 	"github.com/iotaledger/iota.go/v2"
@@ -120,3 +122,96 @@ func TestTransactionBuilder(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionBuilder_DeterministicSerialization(t *testing.T) {
+	identityOne := tpkg.RandEd25519PrivateKey()
+	inputAddr := iotago.AddressFromEd25519PubKey(identityOne.Public().(ed25519.PublicKey))
+	addrKeys := iotago.AddressKeys{Address: &inputAddr, Keys: identityOne}
+
+	inputs := make([]*iotago.ToBeSignedUTXOInput, 5)
+	for i := range inputs {
+		inputs[i] = &iotago.ToBeSignedUTXOInput{
+			Address: &inputAddr,
+			Input:   &iotago.UTXOInput{TransactionID: tpkg.Rand32ByteArray(), TransactionOutputIndex: uint16(i)},
+		}
+	}
+
+	outputs := make([]*iotago.SigLockedSingleOutput, 5)
+	for i := range outputs {
+		outputs[i], _ = tpkg.RandSigLockedSingleOutput(iotago.AddressEd25519)
+	}
+
+	var expected []byte
+	for i := 0; i < 100; i++ {
+		rand.Shuffle(len(inputs), func(i, j int) { inputs[i], inputs[j] = inputs[j], inputs[i] })
+		rand.Shuffle(len(outputs), func(i, j int) { outputs[i], outputs[j] = outputs[j], outputs[i] })
+
+		builder := iotago.NewTransactionBuilder()
+		for _, input := range inputs {
+			builder.AddInput(input)
+		}
+		for _, output := range outputs {
+			builder.AddOutput(output)
+		}
+
+		tx, err := builder.Build(iotago.NewInMemoryAddressSigner(addrKeys))
+		assert.NoError(t, err)
+
+		txBytes, err := tx.Serialize(iotago.DeSeriModePerformValidation)
+		assert.NoError(t, err)
+
+		if expected == nil {
+			expected = txBytes
+			continue
+		}
+		assert.Equal(t, expected, txBytes)
+	}
+}
+
+func TestTransactionBuilder_ReferenceUnlockBlocks(t *testing.T) {
+	identityOne := tpkg.RandEd25519PrivateKey()
+	inputAddr := iotago.AddressFromEd25519PubKey(identityOne.Public().(ed25519.PublicKey))
+	addrKeys := iotago.AddressKeys{Address: &inputAddr, Keys: identityOne}
+
+	outputAddr, _ := tpkg.RandEd25519Address()
+	tx, err := iotago.NewTransactionBuilder().
+		AddInput(&iotago.ToBeSignedUTXOInput{Address: &inputAddr, Input: &iotago.UTXOInput{TransactionID: tpkg.Rand32ByteArray(), TransactionOutputIndex: 0}}).
+		AddInput(&iotago.ToBeSignedUTXOInput{Address: &inputAddr, Input: &iotago.UTXOInput{TransactionID: tpkg.Rand32ByteArray(), TransactionOutputIndex: 1}}).
+		AddOutput(&iotago.SigLockedSingleOutput{Address: outputAddr, Amount: 100}).
+		Build(iotago.NewInMemoryAddressSigner(addrKeys))
+	assert.NoError(t, err)
+
+	// inputs of the same address produce one signature unlock block and a reference to it
+	assert.Len(t, tx.UnlockBlocks, 2)
+	assert.IsType(t, &iotago.SignatureUnlockBlock{}, tx.UnlockBlocks[0])
+	assert.Equal(t, &iotago.ReferenceUnlockBlock{Reference: 0}, tx.UnlockBlocks[1])
+	assert.NoError(t, iotago.ValidateUnlockBlocks(tx.UnlockBlocks, iotago.UnlockBlocksSigUniqueAndRefValidator()))
+}
+
+// outputsNodeAPIClient is a NodeAPIClient which only serves the unspent outputs of a single address.
+type outputsNodeAPIClient struct {
+	iotago.NodeAPIClient
+	outputs map[*iotago.UTXOInput]iotago.Output
+}
+
+func (c *outputsNodeAPIClient) OutputsByEd25519Address(_ context.Context, _ *iotago.Ed25519Address, _ bool) (*iotago.AddressOutputsResponse, map[*iotago.UTXOInput]iotago.Output, error) {
+	return &iotago.AddressOutputsResponse{}, c.outputs, nil
+}
+
+func TestTransactionBuilder_AddInputsViaNodeQuery(t *testing.T) {
+	identityOne := tpkg.RandEd25519PrivateKey()
+	inputAddr := iotago.AddressFromEd25519PubKey(identityOne.Public().(ed25519.PublicKey))
+	outputAddr, _ := tpkg.RandEd25519Address()
+
+	utxoInput, _ := tpkg.RandUTXOInput()
+	nodeAPI := &outputsNodeAPIClient{outputs: map[*iotago.UTXOInput]iotago.Output{
+		utxoInput: &iotago.SigLockedSingleOutput{Address: &inputAddr, Amount: 1337},
+	}}
+
+	tx, err := iotago.NewTransactionBuilder().
+		AddInputsViaNodeQuery(context.Background(), &inputAddr, nodeAPI, nil).
+		AddOutput(&iotago.SigLockedSingleOutput{Address: outputAddr, Amount: 1337}).
+		Build(iotago.NewInMemoryAddressSigner(iotago.AddressKeys{Address: &inputAddr, Keys: identityOne}))
+	assert.NoError(t, err)
+	assert.EqualValues(t, iotago.Serializables{utxoInput}, tx.Essence.(*iotago.TransactionEssence).Inputs)
+}
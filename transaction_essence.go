@@ -4,7 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"sort"
 
 	"golang.org/x/crypto/blake2b"
 )
@@ -85,8 +84,9 @@ type TransactionEssence struct {
 // SortInputsOutputs sorts the inputs and outputs according to their serialized lexical representation.
 // Usually an implicit call to SortInputsOutputs() should be done by instructing serialization to use DeSeriModePerformLexicalOrdering.
 func (u *TransactionEssence) SortInputsOutputs() {
-	sort.Sort(SortedSerializables(u.Inputs))
-	sort.Sort(SortedSerializables(u.Outputs))
+	// elements which fail to serialize are reported by the serialization of the essence itself
+	_ = SortInputsLexically(u.Inputs)
+	_ = SortOutputsLexically(u.Outputs)
 }
 
 // SigningMessage returns the to be signed message.
@@ -138,7 +138,7 @@ func (u *TransactionEssence) Deserialize(data []byte, deSeriMode DeSerialization
 			case uint32(OutputSigLockedSingleOutput):
 			case uint32(OutputSigLockedDustAllowanceOutput):
 			default:
-				return nil, fmt.Errorf("transaction essence can only contain treasury output as outputs but got type ID %d: %w", ty, ErrUnsupportedObjectType)
+				return nil, fmt.Errorf("transaction essence can only contain signature locked single and dust allowance outputs but got type ID %d: %w", ty, ErrUnsupportedObjectType)
 			}
 			return OutputSelector(ty)
 		}, &outputsArrayBound, func(err error) error {
@@ -360,6 +360,12 @@ func (j *jsonTransactionEssence) ToSerializable() (Serializable, error) {
 		if err != nil {
 			return nil, fmt.Errorf("unable to decode output type from JSON, pos %d: %w", i, err)
 		}
+
+		switch jsonOutput.(type) {
+		case *jsonSigLockedSingleOutput, *jsonSigLockedDustAllowanceOutput:
+		default:
+			return nil, fmt.Errorf("%w: transaction essences only allow signature locked single and dust allowance outputs but got type %T instead, pos %d", ErrInvalidJSON, jsonOutput, i)
+		}
 		output, err := jsonOutput.ToSerializable()
 		if err != nil {
 			return nil, fmt.Errorf("pos %d: %w", i, err)
@@ -63,3 +63,30 @@ func TestTransactionEssence_Serialize(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionEssence_MarshalUnmarshalJSON(t *testing.T) {
+	input, _ := tpkg.RandUTXOInput()
+	singleOutput, _ := tpkg.RandSigLockedSingleOutput(iotago.AddressEd25519)
+	dustAllowanceOutput, _ := tpkg.RandSigLockedDustAllowanceOutput(iotago.AddressEd25519)
+	indexation, _ := tpkg.RandIndexation()
+
+	essence := &iotago.TransactionEssence{
+		Inputs:  iotago.Serializables{input},
+		Outputs: iotago.Serializables{singleOutput, dustAllowanceOutput},
+		Payload: indexation,
+	}
+
+	essenceJSON, err := essence.MarshalJSON()
+	assert.NoError(t, err)
+
+	decodedEssence := &iotago.TransactionEssence{}
+	assert.NoError(t, decodedEssence.UnmarshalJSON(essenceJSON))
+	assert.EqualValues(t, essence, decodedEssence)
+
+	treasuryOutput, _ := tpkg.RandTreasuryOutput()
+	essence.Outputs = append(essence.Outputs, treasuryOutput)
+	essenceJSON, err = essence.MarshalJSON()
+	assert.NoError(t, err)
+	err = (&iotago.TransactionEssence{}).UnmarshalJSON(essenceJSON)
+	assert.True(t, errors.Is(err, iotago.ErrInvalidJSON))
+}
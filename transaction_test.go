@@ -160,6 +160,68 @@ func TestTransaction_SemanticallyValidate(t *testing.T) {
 				},
 			}
 		}(),
+		func() test {
+
+			outputAddr1, _ := tpkg.RandEd25519Address()
+			inputUTXO1 := &iotago.UTXOInput{TransactionID: tpkg.Rand32ByteArray(), TransactionOutputIndex: 0}
+
+			builder := iotago.NewTransactionBuilder().
+				AddInput(&iotago.ToBeSignedUTXOInput{Address: &inputAddr, Input: inputUTXO1}).
+				AddOutput(&iotago.SigLockedSingleOutput{Address: outputAddr1, Amount: 50})
+
+			return test{
+				name:       "err - missing UTXO",
+				addrSigner: iotago.NewInMemoryAddressSigner(addrKeys),
+				builder:    builder,
+				validErr:   iotago.ErrMissingUTXO,
+				inputUTXOs: iotago.InputToOutputMapping{},
+			}
+		}(),
+		func() test {
+
+			outputAddr1, _ := tpkg.RandEd25519Address()
+			otherAddr, _ := tpkg.RandEd25519Address()
+			inputUTXO1 := &iotago.UTXOInput{TransactionID: tpkg.Rand32ByteArray(), TransactionOutputIndex: 0}
+
+			builder := iotago.NewTransactionBuilder().
+				AddInput(&iotago.ToBeSignedUTXOInput{Address: &inputAddr, Input: inputUTXO1}).
+				AddOutput(&iotago.SigLockedSingleOutput{Address: outputAddr1, Amount: 50})
+
+			return test{
+				name:       "err - spent UTXO belongs to another address than the signer",
+				addrSigner: iotago.NewInMemoryAddressSigner(addrKeys),
+				builder:    builder,
+				validErr:   iotago.ErrEd25519PubKeyAndAddrMismatch,
+				inputUTXOs: iotago.InputToOutputMapping{
+					inputUTXO1.ID(): &iotago.SigLockedSingleOutput{Address: otherAddr, Amount: 50},
+				},
+			}
+		}(),
+		func() test {
+
+			identityTwo := tpkg.RandEd25519PrivateKey()
+			inputAddr2 := iotago.AddressFromEd25519PubKey(identityTwo.Public().(ed25519.PublicKey))
+			addrKeys2 := iotago.AddressKeys{Address: &inputAddr2, Keys: identityTwo}
+
+			outputAddr1, _ := tpkg.RandEd25519Address()
+			inputUTXO1 := &iotago.UTXOInput{TransactionID: tpkg.Rand32ByteArray(), TransactionOutputIndex: 0}
+			inputUTXO2 := &iotago.UTXOInput{TransactionID: tpkg.Rand32ByteArray(), TransactionOutputIndex: 0}
+
+			builder := iotago.NewTransactionBuilder().
+				AddInput(&iotago.ToBeSignedUTXOInput{Address: &inputAddr, Input: inputUTXO1}).
+				AddInput(&iotago.ToBeSignedUTXOInput{Address: &inputAddr2, Input: inputUTXO2}).
+				AddOutput(&iotago.SigLockedSingleOutput{Address: outputAddr1, Amount: 100})
+
+			return test{
+				name:       "ok - 2 inputs from different addresses",
+				addrSigner: iotago.NewInMemoryAddressSigner(addrKeys, addrKeys2),
+				builder:    builder,
+				inputUTXOs: iotago.InputToOutputMapping{
+					inputUTXO1.ID(): &iotago.SigLockedSingleOutput{Address: &inputAddr, Amount: 50},
+					inputUTXO2.ID(): &iotago.SigLockedSingleOutput{Address: &inputAddr2, Amount: 50},
+				},
+			}
+		}(),
 	}
 
 	for _, test := range tests {
@@ -186,6 +248,26 @@ func TestTransaction_SemanticallyValidate(t *testing.T) {
 
 }
 
+func TestAggregateDustState(t *testing.T) {
+	addr, _ := tpkg.RandEd25519Address()
+	treasuryOutput, _ := tpkg.RandTreasuryOutput()
+
+	allowance, dustCount := iotago.AggregateDustState(iotago.Serializables{
+		&iotago.SigLockedDustAllowanceOutput{Address: addr, Amount: iotago.OutputSigLockedDustAllowanceOutputMinDeposit},
+		&iotago.SigLockedDustAllowanceOutput{Address: addr, Amount: 2 * iotago.OutputSigLockedDustAllowanceOutputMinDeposit},
+		&iotago.SigLockedSingleOutput{Address: addr, Amount: 1},
+		&iotago.SigLockedSingleOutput{Address: addr, Amount: iotago.OutputSigLockedDustAllowanceOutputMinDeposit - 1},
+		&iotago.SigLockedSingleOutput{Address: addr, Amount: iotago.OutputSigLockedDustAllowanceOutputMinDeposit},
+		treasuryOutput,
+	})
+	assert.EqualValues(t, 3*iotago.OutputSigLockedDustAllowanceOutputMinDeposit, allowance)
+	assert.Equal(t, 2, dustCount)
+
+	allowance, dustCount = iotago.AggregateDustState(nil)
+	assert.Zero(t, allowance)
+	assert.Zero(t, dustCount)
+}
+
 func TestDustAllowance(t *testing.T) {
 	identityOne := tpkg.RandEd25519PrivateKey()
 	inputAddr := iotago.AddressFromEd25519PubKey(identityOne.Public().(ed25519.PublicKey))
@@ -2,6 +2,7 @@ package iotago
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 )
 
@@ -12,6 +13,11 @@ const (
 	TreasuryTransactionByteSize = TypeDenotationByteSize + TreasuryInputSerializedBytesSize + TreasuryOutputBytesSize
 )
 
+var (
+	// ErrInvalidTreasuryTransaction gets returned when a treasury transaction is invalid.
+	ErrInvalidTreasuryTransaction = errors.New("invalid treasury transaction")
+)
+
 // TreasuryTransaction represents a transaction which moves funds from the treasury.
 type TreasuryTransaction struct {
 	// The input of this transaction.
@@ -147,3 +153,29 @@ func (j *jsonTreasuryTransaction) ToSerializable() (Serializable, error) {
 
 	return dep, nil
 }
+
+// ValidateTreasuryTransaction validates whether given the following treasury transaction:
+//	- It consumes a TreasuryInput and produces a TreasuryOutput.
+//	- The migrated total does not exceed the previous treasury amount.
+//	- The previous treasury amount minus the migrated total equals the amount of the new TreasuryOutput.
+// This function panics if the treasury transaction is nil.
+func ValidateTreasuryTransaction(tt *TreasuryTransaction, prevTreasuryAmount uint64, migratedTotal uint64) error {
+	if _, isTreasuryInput := tt.Input.(*TreasuryInput); !isTreasuryInput {
+		return fmt.Errorf("%w: must consume a treasury input but got %T instead", ErrInvalidTreasuryTransaction, tt.Input)
+	}
+
+	treasuryOutput, isTreasuryOutput := tt.Output.(*TreasuryOutput)
+	if !isTreasuryOutput {
+		return fmt.Errorf("%w: must produce a treasury output but got %T instead", ErrInvalidTreasuryTransaction, tt.Output)
+	}
+
+	if migratedTotal > prevTreasuryAmount {
+		return fmt.Errorf("%w: migrated total %d exceeds previous treasury amount %d", ErrInvalidTreasuryTransaction, migratedTotal, prevTreasuryAmount)
+	}
+
+	if prevTreasuryAmount-migratedTotal != treasuryOutput.Amount {
+		return fmt.Errorf("%w: new treasury amount mismatch, prev %d, delta %d (migrated funds), new %d", ErrInvalidTreasuryTransaction, prevTreasuryAmount, migratedTotal, treasuryOutput.Amount)
+	}
+
+	return nil
+}
@@ -58,3 +58,51 @@ func TestTreasuryTransaction_Serialize(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTreasuryTransaction(t *testing.T) {
+	type test struct {
+		name          string
+		source        *iotago.TreasuryTransaction
+		prevTreasury  uint64
+		migratedTotal uint64
+		err           error
+	}
+	tests := []test{
+		func() test {
+			treasuryTx, _ := tpkg.RandTreasuryTransaction()
+			treasuryTx.Output.(*iotago.TreasuryOutput).Amount = 8_000_000
+			return test{"ok", treasuryTx, 10_000_000, 2_000_000, nil}
+		}(),
+		func() test {
+			treasuryTx, _ := tpkg.RandTreasuryTransaction()
+			treasuryTx.Output.(*iotago.TreasuryOutput).Amount = 9_000_000
+			return test{"err - new treasury amount mismatch", treasuryTx, 10_000_000, 2_000_000, iotago.ErrInvalidTreasuryTransaction}
+		}(),
+		func() test {
+			treasuryTx, _ := tpkg.RandTreasuryTransaction()
+			treasuryTx.Output.(*iotago.TreasuryOutput).Amount = 0
+			return test{"err - migrated total exceeds previous treasury", treasuryTx, 1_000_000, 2_000_000, iotago.ErrInvalidTreasuryTransaction}
+		}(),
+		func() test {
+			treasuryTx, _ := tpkg.RandTreasuryTransaction()
+			treasuryTx.Input, _ = tpkg.RandUTXOInput()
+			return test{"err - non treasury input", treasuryTx, 10_000_000, 2_000_000, iotago.ErrInvalidTreasuryTransaction}
+		}(),
+		func() test {
+			treasuryTx, _ := tpkg.RandTreasuryTransaction()
+			treasuryTx.Output, _ = tpkg.RandSigLockedSingleOutput(iotago.AddressEd25519)
+			return test{"err - non treasury output", treasuryTx, 10_000_000, 2_000_000, iotago.ErrInvalidTreasuryTransaction}
+		}(),
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := iotago.ValidateTreasuryTransaction(tt.source, tt.prevTreasury, tt.migratedTotal)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -108,6 +108,27 @@ func (s *SignatureUnlockBlock) UnmarshalJSON(bytes []byte) error {
 	return nil
 }
 
+// VerifyUnlockBlockSignature verifies that the signature of the given SignatureUnlockBlock signs the essence
+// and that the public key embedded in the signature corresponds to the address being spent.
+// For Ed25519 addresses, ErrEd25519PubKeyAndAddrMismatch is returned if the public key does not correspond to the address
+// and ErrEd25519SignatureInvalid if the signature itself is invalid.
+func VerifyUnlockBlockSignature(block *SignatureUnlockBlock, essence []byte, addr Address) error {
+	if block.Signature == nil {
+		return ErrSigUnlockBlockHasNilSig
+	}
+
+	switch addr := addr.(type) {
+	case *Ed25519Address:
+		ed25519Sig, isEd25519Sig := block.Signature.(*Ed25519Signature)
+		if !isEd25519Sig {
+			return fmt.Errorf("%w: Ed25519 address but signature is of type %T", ErrSignatureAndAddrIncompatible, block.Signature)
+		}
+		return ed25519Sig.Valid(essence, addr)
+	default:
+		return fmt.Errorf("%w: type %T", ErrUnknownAddrType, addr)
+	}
+}
+
 // ReferenceUnlockBlock is an unlock block which references a previous unlock block.
 type ReferenceUnlockBlock struct {
 	// The other unlock block this reference unlock block references to.
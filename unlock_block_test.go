@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/iotaledger/iota.go/v2"
+	"github.com/iotaledger/iota.go/v2/ed25519"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -186,3 +187,41 @@ func TestUnlockBlockValidatorFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyUnlockBlockSignature(t *testing.T) {
+	prvKey := tpkg.RandEd25519PrivateKey()
+	pubKey := prvKey.Public().(ed25519.PublicKey)
+	addr := iotago.AddressFromEd25519PubKey(pubKey)
+	essence := tpkg.RandBytes(100)
+
+	sig := &iotago.Ed25519Signature{}
+	copy(sig.PublicKey[:], pubKey)
+	copy(sig.Signature[:], ed25519.Sign(prvKey, essence))
+	block := &iotago.SignatureUnlockBlock{Signature: sig}
+
+	otherAddr, _ := tpkg.RandEd25519Address()
+
+	tests := []struct {
+		name    string
+		block   *iotago.SignatureUnlockBlock
+		essence []byte
+		addr    iotago.Address
+		err     error
+	}{
+		{"ok", block, essence, &addr, nil},
+		{"err - signature over other essence", block, tpkg.RandBytes(100), &addr, iotago.ErrEd25519SignatureInvalid},
+		{"err - public key doesn't match address", block, essence, otherAddr, iotago.ErrEd25519PubKeyAndAddrMismatch},
+		{"err - nil signature", &iotago.SignatureUnlockBlock{}, essence, &addr, iotago.ErrSigUnlockBlockHasNilSig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := iotago.VerifyUnlockBlockSignature(tt.block, tt.essence, tt.addr)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -54,6 +54,12 @@ func (u *UTXOInput) ID() UTXOInputID {
 	return id
 }
 
+// OutputIDHex returns the hex output ID of the output the UTXOInput references,
+// in the form the node API uses in its routes and responses.
+func (u *UTXOInput) OutputIDHex() OutputIDHex {
+	return OutputIDHex(u.ID().ToHex())
+}
+
 func (u *UTXOInput) Deserialize(data []byte, deSeriMode DeSerializationMode) (int, error) {
 	return NewDeserializer(data).
 		AbortIf(func(err error) error {
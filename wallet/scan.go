@@ -0,0 +1,112 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/iotaledger/iota.go/v2"
+)
+
+const (
+	// IOTACoinType defines the SLIP-0044 registered coin type of IOTA.
+	IOTACoinType = 4218
+	// DefaultGapLimit defines the amount of consecutive unused addresses after which address discovery stops.
+	DefaultGapLimit = 20
+	// ExternalChain defines the change level of addresses used to receive funds.
+	ExternalChain uint32 = 0
+	// InternalChain defines the change level of addresses used to hold remainders.
+	InternalChain uint32 = 1
+)
+
+// ErrInvalidGapLimit gets returned when the gap limit for an address scan is not positive.
+var ErrInvalidGapLimit = errors.New("gap limit must be greater than zero")
+
+// AddressDerivationPath returns the derivation path "m/44'/4218'/account'/change'/index'" of an IOTA Ed25519 address.
+func AddressDerivationPath(account uint32, change uint32, index uint32) string {
+	return fmt.Sprintf("m/44'/%d'/%d'/%d'/%d'", IOTACoinType, account, change, index)
+}
+
+// AddressWithBalance is an address discovered during a Scan.
+type AddressWithBalance struct {
+	// The chain within the derivation path: ExternalChain or InternalChain.
+	Change uint32
+	// The address index within the derivation path.
+	Index uint32
+	// The derived address.
+	Address *iotago.Ed25519Address
+	// The balance of the address.
+	Balance uint64
+	// The IDs of the unspent outputs residing on the address.
+	UnspentOutputIDs []iotago.OutputIDHex
+}
+
+// Scan derives the addresses of the first account's external and internal chain from the given seed and queries their state from the node.
+// An address is considered used if any output, spent or unspent, ever resided on it.
+// The scan of each chain stops after gapLimit consecutive unused addresses. All used addresses are returned,
+// the ones of the external chain first.
+func Scan(ctx context.Context, nodeAPI iotago.NodeAPIClient, seed []byte, gapLimit int) ([]AddressWithBalance, error) {
+	if gapLimit <= 0 {
+		return nil, ErrInvalidGapLimit
+	}
+
+	var discovered []AddressWithBalance
+	for _, change := range []uint32{ExternalChain, InternalChain} {
+		chainDiscovered, err := scanChain(ctx, nodeAPI, seed, change, gapLimit)
+		if err != nil {
+			return nil, err
+		}
+		discovered = append(discovered, chainDiscovered...)
+	}
+
+	return discovered, nil
+}
+
+func scanChain(ctx context.Context, nodeAPI iotago.NodeAPIClient, seed []byte, change uint32, gapLimit int) ([]AddressWithBalance, error) {
+	var discovered []AddressWithBalance
+	for index, unused := uint32(0), 0; unused < gapLimit; index++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		_, pubKey, err := DeriveEd25519KeyFromSeed(seed, AddressDerivationPath(0, change, index))
+		if err != nil {
+			return nil, err
+		}
+		addr := iotago.AddressFromEd25519PubKey(pubKey)
+
+		allOutputs, err := nodeAPI.OutputIDsByEd25519Address(ctx, &addr, true)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query outputs of address %d/%d: %w", change, index, err)
+		}
+		if len(allOutputs.OutputIDs) == 0 {
+			unused++
+			continue
+		}
+		unused = 0
+
+		balanceRes, err := nodeAPI.BalanceByEd25519Address(ctx, &addr)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query balance of address %d/%d: %w", change, index, err)
+		}
+
+		// every output holds a deposit, so an address without balance has no unspent outputs
+		unspentOutputIDs := []iotago.OutputIDHex{}
+		if balanceRes.Balance > 0 {
+			unspentOutputIDs, err = nodeAPI.AllOutputIDsByEd25519Address(ctx, &addr, false)
+			if err != nil {
+				return nil, fmt.Errorf("unable to query unspent outputs of address %d/%d: %w", change, index, err)
+			}
+		}
+
+		discovered = append(discovered, AddressWithBalance{
+			Change:           change,
+			Index:            index,
+			Address:          &addr,
+			Balance:          balanceRes.Balance,
+			UnspentOutputIDs: unspentOutputIDs,
+		})
+	}
+
+	return discovered, nil
+}
@@ -0,0 +1,94 @@
+package wallet_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/h2non/gock.v1"
+
+	"github.com/iotaledger/iota.go/v2"
+	"github.com/iotaledger/iota.go/v2/tpkg"
+	"github.com/iotaledger/iota.go/v2/wallet"
+)
+
+const nodeAPIUrl = "http://127.0.0.1:14265"
+
+func TestScan(t *testing.T) {
+	defer gock.Off()
+
+	seed := tpkg.RandBytes(64)
+	const gapLimit = 2
+
+	deriveAddr := func(change uint32, index uint32) *iotago.Ed25519Address {
+		_, pubKey, err := wallet.DeriveEd25519KeyFromSeed(seed, wallet.AddressDerivationPath(0, change, index))
+		require.NoError(t, err)
+		addr := iotago.AddressFromEd25519PubKey(pubKey)
+		return &addr
+	}
+
+	mockOutputIDs := func(addr *iotago.Ed25519Address, includeSpent bool, outputIDs ...iotago.OutputIDHex) {
+		req := gock.New(nodeAPIUrl).Get(fmt.Sprintf(iotago.NodeAPIRouteAddressEd25519Outputs, addr.String()))
+		if includeSpent {
+			req.MatchParam("include-spent", "true")
+		}
+		req.Reply(200).JSON(&iotago.HTTPOkResponseEnvelope{Data: &iotago.AddressOutputsResponse{Address: addr.String(), OutputIDs: append([]iotago.OutputIDHex{}, outputIDs...)}})
+	}
+
+	mockBalance := func(addr *iotago.Ed25519Address, balance uint64) {
+		gock.New(nodeAPIUrl).
+			Get(fmt.Sprintf(iotago.NodeAPIRouteAddressEd25519Balance, addr.String())).
+			Reply(200).
+			JSON(&iotago.HTTPOkResponseEnvelope{Data: &iotago.AddressBalanceResponse{Address: addr.String(), Balance: balance}})
+	}
+
+	randOutputID := func() iotago.OutputIDHex {
+		return iotago.OutputIDHex(fmt.Sprintf("%x0000", tpkg.Rand32ByteArray()))
+	}
+
+	// external chain: only address 1 is used and holds funds, its unspent outputs are served in two pages
+	externalOutputID := randOutputID()
+	externalSecondPageOutputID := randOutputID()
+	external := []*iotago.Ed25519Address{deriveAddr(wallet.ExternalChain, 0), deriveAddr(wallet.ExternalChain, 1), deriveAddr(wallet.ExternalChain, 2), deriveAddr(wallet.ExternalChain, 3)}
+	mockOutputIDs(external[0], true)
+	mockOutputIDs(external[1], true, externalOutputID)
+	mockBalance(external[1], 1337)
+	gock.New(nodeAPIUrl).
+		Get(fmt.Sprintf(iotago.NodeAPIRouteAddressEd25519Outputs, external[1].String())).
+		MatchParam("cursor", "page2").
+		Reply(200).
+		JSON(&iotago.HTTPOkResponseEnvelope{Data: &iotago.AddressOutputsResponse{Address: external[1].String(), OutputIDs: []iotago.OutputIDHex{externalSecondPageOutputID}}})
+	gock.New(nodeAPIUrl).
+		Get(fmt.Sprintf(iotago.NodeAPIRouteAddressEd25519Outputs, external[1].String())).
+		Reply(200).
+		JSON(&iotago.HTTPOkResponseEnvelope{Data: &iotago.AddressOutputsResponse{Address: external[1].String(), OutputIDs: []iotago.OutputIDHex{externalOutputID}, Cursor: "page2"}})
+	mockOutputIDs(external[2], true)
+	mockOutputIDs(external[3], true)
+
+	// internal chain: address 0 only held spent outputs, address 2 holds a remainder
+	internalOutputID := randOutputID()
+	internal := []*iotago.Ed25519Address{deriveAddr(wallet.InternalChain, 0), deriveAddr(wallet.InternalChain, 1), deriveAddr(wallet.InternalChain, 2), deriveAddr(wallet.InternalChain, 3), deriveAddr(wallet.InternalChain, 4)}
+	mockOutputIDs(internal[0], true, randOutputID())
+	mockBalance(internal[0], 0)
+	mockOutputIDs(internal[1], true)
+	mockOutputIDs(internal[2], true, internalOutputID)
+	mockBalance(internal[2], 42)
+	mockOutputIDs(internal[2], false, internalOutputID)
+	mockOutputIDs(internal[3], true)
+	mockOutputIDs(internal[4], true)
+
+	nodeAPI := iotago.NewNodeHTTPAPIClient(nodeAPIUrl)
+	discovered, err := wallet.Scan(context.Background(), nodeAPI, seed, gapLimit)
+	require.NoError(t, err)
+	require.EqualValues(t, []wallet.AddressWithBalance{
+		{Change: wallet.ExternalChain, Index: 1, Address: external[1], Balance: 1337, UnspentOutputIDs: []iotago.OutputIDHex{externalOutputID, externalSecondPageOutputID}},
+		{Change: wallet.InternalChain, Index: 0, Address: internal[0], Balance: 0, UnspentOutputIDs: []iotago.OutputIDHex{}},
+		{Change: wallet.InternalChain, Index: 2, Address: internal[2], Balance: 42, UnspentOutputIDs: []iotago.OutputIDHex{internalOutputID}},
+	}, discovered)
+	require.True(t, gock.IsDone())
+
+	_, err = wallet.Scan(context.Background(), nodeAPI, seed, 0)
+	require.True(t, errors.Is(err, wallet.ErrInvalidGapLimit))
+}
@@ -0,0 +1,129 @@
+// Package wallet provides hierarchical deterministic key derivation for Ed25519 addresses.
+package wallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+
+	"github.com/iotaledger/iota.go/v2/ed25519"
+)
+
+const (
+	// the amount of PBKDF2 iterations used to derive a seed from a mnemonic as defined in BIP-0039.
+	mnemonicPBKDF2Iterations = 2048
+	// the salt prefix used to derive a seed from a mnemonic as defined in BIP-0039.
+	mnemonicSaltPrefix = "mnemonic"
+	// MnemonicSeedLength defines the length of a seed derived from a mnemonic.
+	MnemonicSeedLength = 64
+
+	// the HMAC key used to derive the master key as defined in SLIP-0010.
+	ed25519MasterKeyHMACKey = "ed25519 seed"
+	// HardenedKeyOffset defines the offset added to an index of a hardened derivation path segment.
+	HardenedKeyOffset uint32 = 1 << 31
+)
+
+var (
+	// ErrInvalidMnemonic gets returned when a mnemonic is malformed.
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
+	// ErrInvalidDerivationPath gets returned when a derivation path is malformed.
+	ErrInvalidDerivationPath = errors.New("invalid derivation path")
+	// ErrNonHardenedDerivation gets returned when a derivation path contains a non-hardened segment, which Ed25519 does not support.
+	ErrNonHardenedDerivation = errors.New("ed25519 only supports hardened derivation")
+	// ErrInvalidSeedLength gets returned when a seed is too short or too long for key derivation.
+	ErrInvalidSeedLength = errors.New("invalid seed length")
+)
+
+// MnemonicToSeed derives the BIP-0039 seed from the given mnemonic and passphrase.
+// Only lowercase US-ASCII mnemonics consisting of 12, 15, 18, 21 or 24 words are accepted,
+// as these need no further Unicode normalization. The words are not checked against a word list.
+func MnemonicToSeed(mnemonic string, passphrase string) ([]byte, error) {
+	words := strings.Fields(mnemonic)
+	switch len(words) {
+	case 12, 15, 18, 21, 24:
+	default:
+		return nil, fmt.Errorf("%w: must consist of 12, 15, 18, 21 or 24 words but has %d", ErrInvalidMnemonic, len(words))
+	}
+
+	for i, word := range words {
+		for _, c := range word {
+			if c < 'a' || c > 'z' {
+				return nil, fmt.Errorf("%w: word %d contains non lowercase US-ASCII character %q", ErrInvalidMnemonic, i, c)
+			}
+		}
+	}
+
+	return pbkdf2.Key([]byte(strings.Join(words, " ")), []byte(mnemonicSaltPrefix+passphrase), mnemonicPBKDF2Iterations, MnemonicSeedLength, sha512.New), nil
+}
+
+// ParseDerivationPath parses the given derivation path in the form of "m/44'/4218'/0'/0'/0'" into its segment indices.
+// Hardened segments (suffixed by ' or H) get HardenedKeyOffset added to their index.
+func ParseDerivationPath(path string) ([]uint32, error) {
+	segments := strings.Split(path, "/")
+	if segments[0] != "m" {
+		return nil, fmt.Errorf("%w: must start with 'm' but is %s", ErrInvalidDerivationPath, path)
+	}
+
+	indices := make([]uint32, 0, len(segments)-1)
+	for _, segment := range segments[1:] {
+		hardened := strings.HasSuffix(segment, "'") || strings.HasSuffix(segment, "H")
+		if hardened {
+			segment = segment[:len(segment)-1]
+		}
+
+		index, err := strconv.ParseUint(segment, 10, 32)
+		if err != nil || uint32(index) >= HardenedKeyOffset {
+			return nil, fmt.Errorf("%w: invalid segment %q", ErrInvalidDerivationPath, segment)
+		}
+
+		if hardened {
+			index += uint64(HardenedKeyOffset)
+		}
+		indices = append(indices, uint32(index))
+	}
+
+	return indices, nil
+}
+
+// DeriveEd25519KeyFromSeed derives the Ed25519 key pair for the given derivation path from the seed as defined in SLIP-0010.
+// As Ed25519 only supports hardened derivation, every segment of the path must be hardened.
+func DeriveEd25519KeyFromSeed(seed []byte, path string) (ed25519.PrivateKey, ed25519.PublicKey, error) {
+	// BIP-0032 defines seeds to be between 128 and 512 bits
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, nil, fmt.Errorf("%w: must be between 16 and 64 bytes but is %d", ErrInvalidSeedLength, len(seed))
+	}
+
+	indices, err := ParseDerivationPath(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key, chainCode := hmacSHA512([]byte(ed25519MasterKeyHMACKey), seed)
+	for _, index := range indices {
+		if index < HardenedKeyOffset {
+			return nil, nil, fmt.Errorf("%w: segment %d of path %s", ErrNonHardenedDerivation, index, path)
+		}
+
+		data := make([]byte, 1+len(key)+4)
+		copy(data[1:], key)
+		binary.BigEndian.PutUint32(data[1+len(key):], index)
+		key, chainCode = hmacSHA512(chainCode, data)
+	}
+
+	prvKey := ed25519.NewKeyFromSeed(key)
+	return prvKey, prvKey.Public().(ed25519.PublicKey), nil
+}
+
+// computes HMAC-SHA512 of data with the given key and returns the left and right halves.
+func hmacSHA512(key []byte, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
@@ -0,0 +1,115 @@
+package wallet_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota.go/v2/wallet"
+)
+
+func TestMnemonicToSeed(t *testing.T) {
+	tests := []struct {
+		name       string
+		mnemonic   string
+		passphrase string
+		seedHex    string
+		err        error
+	}{
+		{
+			name:       "ok - BIP-0039 test vector",
+			mnemonic:   "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			passphrase: "TREZOR",
+			seedHex:    "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+		},
+		{
+			name:     "err - invalid word count",
+			mnemonic: "abandon abandon abandon",
+			err:      wallet.ErrInvalidMnemonic,
+		},
+		{
+			name:     "err - non lowercase characters",
+			mnemonic: "Abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+			err:      wallet.ErrInvalidMnemonic,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seed, err := wallet.MnemonicToSeed(tt.mnemonic, tt.passphrase)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.seedHex, hex.EncodeToString(seed))
+		})
+	}
+}
+
+func TestDeriveEd25519KeyFromSeed(t *testing.T) {
+	// SLIP-0010 test vector 1 for ed25519
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		path      string
+		prvKeyHex string
+		pubKeyHex string
+		err       error
+	}{
+		{
+			name:      "ok - master",
+			path:      "m",
+			prvKeyHex: "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+			pubKeyHex: "a4b2856bfec510abab89753fac1ac0e1112364e7d250545963f135f2a33188ed",
+		},
+		{
+			name:      "ok - m/0H",
+			path:      "m/0H",
+			prvKeyHex: "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+			pubKeyHex: "8c8a13df77a28f3445213a0f432fde644acaa215fc72dcdf300d5efaa85d350c",
+		},
+		{
+			name:      "ok - m/0H/1H",
+			path:      "m/0'/1'",
+			prvKeyHex: "b1d0bad404bf35da785a64ca1ac54b2617211d2777696fbffaf208f746ae84f2",
+			pubKeyHex: "1932a5270f335bed617d5b935c80aedb1a35bd9fc1e31acafd5372c30f5c1187",
+		},
+		{
+			name: "err - non hardened segment",
+			path: "m/0'/1",
+			err:  wallet.ErrNonHardenedDerivation,
+		},
+		{
+			name: "err - missing master",
+			path: "0'/1'",
+			err:  wallet.ErrInvalidDerivationPath,
+		},
+		{
+			name: "err - invalid segment",
+			path: "m/a'",
+			err:  wallet.ErrInvalidDerivationPath,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prvKey, pubKey, err := wallet.DeriveEd25519KeyFromSeed(seed, tt.path)
+			if tt.err != nil {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.prvKeyHex, hex.EncodeToString(prvKey.Seed()))
+			assert.Equal(t, tt.pubKeyHex, hex.EncodeToString(pubKey))
+		})
+	}
+
+	_, _, err = wallet.DeriveEd25519KeyFromSeed(seed[:15], "m")
+	assert.True(t, errors.Is(err, wallet.ErrInvalidSeedLength))
+}